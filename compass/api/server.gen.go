@@ -8,19 +8,45 @@ import (
 	"compress/gzip"
 	"encoding/base64"
 	"fmt"
+	"net/http"
 	"net/url"
 	"path"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
+	"github.com/oapi-codegen/runtime"
 )
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Reload the control catalogs
+	// (POST /v1/admin/reload)
+	PostV1AdminReload(c *gin.Context)
+	// List the policy rules that assess a control
+	// (GET /v1/controls/{id}/rules)
+	GetV1ControlsIdRules(c *gin.Context, id string, params GetV1ControlsIdRulesParams)
+	// Report policy coverage of a catalog
+	// (GET /v1/coverage)
+	GetV1Coverage(c *gin.Context, params GetV1CoverageParams)
+	// List the procedures loaded by each mapper
+	// (GET /v1/debug/plans)
+	GetV1DebugPlans(c *gin.Context)
+	// List policy engines with registered mappers
+	// (GET /v1/engines)
+	GetV1Engines(c *gin.Context)
 	// Enrich telemetry attributes with compliance control data
 	// (POST /v1/enrich)
 	PostV1Enrich(c *gin.Context)
+	// List the policy rules compass can map
+	// (GET /v1/rules)
+	GetV1Rules(c *gin.Context)
+	// List the telemetry attributes emitted by complybeacon
+	// (GET /v1/schema)
+	GetV1Schema(c *gin.Context)
+	// Summarize compliance status by framework for a batch of evidence
+	// (POST /v1/summary)
+	PostV1Summary(c *gin.Context)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -32,6 +58,113 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
+// PostV1AdminReload operation middleware
+func (siw *ServerInterfaceWrapper) PostV1AdminReload(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostV1AdminReload(c)
+}
+
+// GetV1ControlsIdRules operation middleware
+func (siw *ServerInterfaceWrapper) GetV1ControlsIdRules(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1ControlsIdRulesParams
+
+	// ------------- Optional query parameter "catalogId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "catalogId", c.Request.URL.Query(), &params.CatalogId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter catalogId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV1ControlsIdRules(c, id, params)
+}
+
+// GetV1Coverage operation middleware
+func (siw *ServerInterfaceWrapper) GetV1Coverage(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1CoverageParams
+
+	// ------------- Required query parameter "catalog" -------------
+
+	if paramValue := c.Query("catalog"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument catalog is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "catalog", c.Request.URL.Query(), &params.Catalog)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter catalog: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV1Coverage(c, params)
+}
+
+// GetV1DebugPlans operation middleware
+func (siw *ServerInterfaceWrapper) GetV1DebugPlans(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV1DebugPlans(c)
+}
+
+// GetV1Engines operation middleware
+func (siw *ServerInterfaceWrapper) GetV1Engines(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV1Engines(c)
+}
+
 // PostV1Enrich operation middleware
 func (siw *ServerInterfaceWrapper) PostV1Enrich(c *gin.Context) {
 
@@ -45,6 +178,45 @@ func (siw *ServerInterfaceWrapper) PostV1Enrich(c *gin.Context) {
 	siw.Handler.PostV1Enrich(c)
 }
 
+// GetV1Rules operation middleware
+func (siw *ServerInterfaceWrapper) GetV1Rules(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV1Rules(c)
+}
+
+// GetV1Schema operation middleware
+func (siw *ServerInterfaceWrapper) GetV1Schema(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetV1Schema(c)
+}
+
+// PostV1Summary operation middleware
+func (siw *ServerInterfaceWrapper) PostV1Summary(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostV1Summary(c)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL      string
@@ -72,43 +244,106 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.POST(options.BaseURL+"/v1/admin/reload", wrapper.PostV1AdminReload)
+	router.GET(options.BaseURL+"/v1/controls/:id/rules", wrapper.GetV1ControlsIdRules)
+	router.GET(options.BaseURL+"/v1/coverage", wrapper.GetV1Coverage)
+	router.GET(options.BaseURL+"/v1/debug/plans", wrapper.GetV1DebugPlans)
+	router.GET(options.BaseURL+"/v1/engines", wrapper.GetV1Engines)
 	router.POST(options.BaseURL+"/v1/enrich", wrapper.PostV1Enrich)
+	router.GET(options.BaseURL+"/v1/rules", wrapper.GetV1Rules)
+	router.GET(options.BaseURL+"/v1/schema", wrapper.GetV1Schema)
+	router.POST(options.BaseURL+"/v1/summary", wrapper.PostV1Summary)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/7RYW2/juBX+KwRboC0gO0pmty38ls1ksC66iRtn+9BNHhjx2OaGIjUkZY8xyH8vDklJ",
-	"1MVJBtO+2ebl3L7znY/+SgtdVlqBcpYuvlJb7KBk/uOVLispmCoAvzHOhRNaMbkyugLjBFi62DBpIaMc",
-	"bGFEhet0kRwkHBwT0pKN0SW5vVp/ImsoaiPckVxp5YyWZGX0RkiY04xWyc3omN+AH/9oYEMX9A9nnbNn",
-	"0dOzzlq8kb5kFJQRxa4E5daOudrf13cy/E70hrgdkKJzuTtKKqMLsHZB1nWBHzLyqypZVQHPyIoZJ5jE",
-	"n56VPqiMaEPWzwJXMRZQdUkXv9F4lGa0OUszGg/7H/1pmtF4lj5mFL6wspKAXran3bHCH6wzQm0xxI1h",
-	"JRy0ebbvz9Cn7sxLRo2wz+8/e4e7XzJqTyQ0qXrc0iWhWXM0ozdazdLv11+grMKCI5dVJUXBniQkuell",
-	"ZHh8kBcMCz7XwgBHww2GeulqY5jAyWNGnXDeUoL/1ox++h0Kh2kYw24MsQbq0Qsi1EabkuEy2WiToo5Z",
-	"C9aiI6M+YDEnQgp3HFu5VnthtMKjlvhLlYMvzpLDDgwQtxO2dcBfBT7wJqG/0ZXRvC78bRm2xRYT+ZhR",
-	"4aD0DoygF39gxrAjfi+YY1Jvl3zs3a9KfK6BCA7KiY0A4wPHlrPD7MRbMIa2WKmn9Ha9Ws9+muqFgjnY",
-	"ajORnau44m9lpZBH4nbMTXvwBFKrrSVO9+xe+h5sCGvKvvi+yJ9AqG2EgOeHQcz/upzlf5vn51OmDZTA",
-	"hcfUx9T+0J1ksSE9A4UuS1AcOEmuIdYZzNoxOtzhp+fZHZR6D8Ro7UhtwRAW0sQUJwL3NBRagSHLy19I",
-	"paUoAvpeb1qBKegwlZT38dVG/NRjxJPs1ILLuxoNe2eT/hx14eaVy+9gW0vmIsyE4rV15og0qDgz3MYC",
-	"w57Jmjngg+bvt+PNcn0/+3uez378gP14ezW7+LZuTCJ6PRG90FuYxmGNAOliHkbQd/nyaobYvLr66/z8",
-	"W3wd1L1H0b0oXq/7XZxjpwMV9jlh2FfrLGEPE1yONohfw4t0IXwdD8LtiNJq1i9mM/WMcKLwY/5nsd3R",
-	"jP4CXNQlzeg/9YFmdNn5wWR/zMUD40YZ5eG6HWF38LkG66bw6RdIxY5SswA/B9ihCFPmnBFPtUulT1rf",
-	"rxT2CI+gA30XH6/VVii4YaXnqNUlpjEsBIQIrRrhRT8xIT1iwo67WgJOCspBHWdIHzOkDyw5O3xkjqEV",
-	"A8wG14f0IixR2hEmpT74Ww3YWrp4n8eYKME6VlZ0QS/yix9m+fns/Mf783zxIV/k+X98GvtFTwN8TQ1d",
-	"N/uG0G0veHyjQrbSysLUFMc9wFNNsBGKY9d5lAUMN6UKNOt2BpgjKCuF2tp5v2pFT78nanogKE4rgGSu",
-	"d8O3m7XjwSj4xMg6NaG+Y4JMKvxELPfJOv12kl/7rDnktEQtR4IIHZro4YEyHYOsX4/3ie4JQdsuTULN",
-	"GG18mw5M8wnM/Xx/v4panfgdCXx+yPOMBnaiCyqU+3DR0ZFQDrZg0GAJ1rLtFKDRE9Isv63Tvflm+2Ro",
-	"SY9OMD0gf8UtBGWkn2KBcgh4ugrImhTdxGkt7WgYjMluaBt/bfRUz1iQmRUYTCJwvwFackSdAMj+RQA5",
-	"a7ovUX6eVUcT9BTNjjkfaXHoWnssGVP47rqrlX+WRv3ZUvYNALfkDvYCDu9+orWnTzjfTID3K+bovqkl",
-	"jLRUl8m+ch6Nl7F07ubNIHfsQP6xvr0hunZV7TpB1Ktwn21LcIzH296cQBndg7HB2Pk8D6rtOybesFcS",
-	"B4ax3e+A4DI+D1V4BrBD1zkHZskWFJihyjsVSMsRnDmY4c1vNnvnXTbusAFITgJ+TBFoBpXdOObL1bKF",
-	"EpIFs5ZYMHtRwJzc4wMnfsNhg5loXzyzJ2aBT0qkgYrC2mcPCqeD8QOUIEsaxSThumRCISmJIs7tzo/K",
-	"aHT/TzYlJmwqCXwL8we1xDUOVmwV0ogmT0AKJiVw8nQkTJHbCtR968eVlhIKpw3eWFuHJBj+xkJ3dQwA",
-	"nbEZwSOisFnwyrAC7PwBmSD9DwS9XMf8XK6WPfDm8whfXYFilaAL+mGez3GyVsztPC+d7c/PgtVAqVMK",
-	"FZVE5SxhxIInrWc4TklUS/4M8+0889TtyPJj1vSkYiVkoW+WH/+CAT0oA642ypLuv8skyTMD0hNIcnmo",
-	"tlbTtZs/KA8WULzSAt8R1m/0D+jvKkxIOk4eD3EvuFbaun+fB10Y30Ng3U+aHxs5hzq9k3N48Ox3G97+",
-	"QUm8KWdHT4eXfq86U4P/IchWX86LPP+/OBCVsfdg8D9a0HWbWspjxG+vbNSf2DBPiv8rz7yQmnCmVvCl",
-	"ggKBA3FPRm1dlsxL4hDRNHS9jk+6vPn7x4+Nl5eXl/8GAAD//7oavvaNFwAA",
+	"H4sIAAAAAAACA+1c/2/buJL/VwjfAe8OsB2n3d4+9A4HuEm6m4fmyyXdXbzbLFpaom2+yJQeKSX1Lfq/",
+	"3wy/iBRF2XGzvdcfDiiaxBJHw+F8/czIv4+yclOVgolajV7/PlLZmm2o/nVe15IvmpqdsiUXvOalwI9z",
+	"pjLJK/PnaE5qVrANq+WWULeAsA2va5aTxZYg+WK7YDSD+8ejSpYVkzVnqkcrJn3BqOBiRcolqdfMUwcq",
+	"7BMFqgxuunpgkhaFeQynImMkZzWTGy4o0iHLUprlSjH4lxPJVNlIuA8uAE+1LAugWG8rJKfgGWI1+jwe",
+	"3bNtYrftDvFyyId//lTVtG5UnyYQlezvDZcsH73+dWQohPR/a5eUi7+xrEY2TmhNi3J1LcuM5Y1MiG3k",
+	"r5GipDlsEfdMiYKnFoxkhkJP9vbz87xP8SfB/94wwnPQCr7kTLZStAILiAZHcXt9O3mTkmW1g/t3hmV/",
+	"C+GCqFKi+pyfwiHlTAJNXrONXv3Pki1h2T8deb09skp71EriglaVfbZlhkpJt70z8DLoMJk8iPaAkQua",
+	"59ogaHEdCHVJC8XG0QZPOppJeaHIUpYbcnVy+5bcsqyRvN6SEytYILfkBZv2j8uq6h4R+KdZisi7XZuQ",
+	"/hxMRzke3G1kQ2sgp80XD70qC55tiWxAmx7XTKAJNUWtCJVgV6uVZCuKx0UzWSrldENNyXtYvORS1QR4",
+	"BAfBlaEn+YbK9nnTpx5vcm/d8wV9FJJn6w0svDV22Nuz+dy5lcBx+KVGHZV6TW6bDH8Zk5/EBpSK5WNy",
+	"TeFQaIEf3YvyUYzRk9zec7yKe2Gi2aBy2aXwiVsLv9rF+kO9Gn6za1HtvDX51T1zWkq6YY+lvD9AYm/9",
+	"Gm0DG5Zz7SDnWdr73vhb4GD1D8ngESCe3KuGPevWP5h9Bn7VCeNNUWb38DcoXPkIPx159Oa/UP6APy9L",
+	"8DbbQDQdgTgKPXFIru6fLogbvBtWqQHtCMy1deRuE+5arZkVk/Dvs09sU5kLNZlX8HlGF4XeFmO5Ijfs",
+	"gbPHoc3F1HaHDi/eQBXaLSVsAB0ar/WTAj+208udeH8TmU/kLsBfw+lvfLQNLMoEXWSk58+oFREvgFb/",
+	"KWfigctS4FLlIjX7BL+DAwKvU6/BlzgGNCmmwmD0K0bFvDG6PUaTX6Egfws8TU+PYk/imW/jSp/P0zJr",
+	"8BazfZeqtCt9XIMLFPzgAy0a7SwjxzqGReRRYtokMALiZXszEq4KKjrB9mTNsntDcyFB1mt8Us2coWpd",
+	"AQddk4JR8L9gDigmWQLJacqIvigbiCNH6w7gequZT8wR7NKfmVRJf2QvIGkJpqQCcQ+y0SibDDnXhM8K",
+	"2Xkxe/FqOnsxffFqgCW2KmVCO0/sFb1RuuHF1hxFkpsFK0qxgtBXdp491/7dBf7U8/nzDmPBMHt2eW//",
+	"GP5rPpl9P50dJzM2iscHebQaznYSJhQlpo4IQUVmaui8xphNm5jSPhdyv5Bj4CGbfP+hkpsPx0D5ZWCv",
+	"3nkFUe10V2ERXHQshaEtIAMhQOJRb62UvdMZmySus3bIB1hz1L9b007lzxASywcgV0IAAc2FFN5oCBU5",
+	"4XiPcygVimd+YdyHcXx9Z2bcfbz1H5sNFRPJaI7Biei7ho8F9myv9VLAMPivwXUJ8DCxepcN8HtBBV0x",
+	"GwJ2hzWOShqm5K0B7k7I33byocFw3nokLdHuobQRrBenljuI37BVU9DaOgIu8kZhmgtxGJRBQtA3Juid",
+	"fjc8dgPW5fnt+8mfZ7PJq5cYsa5OJi8Oi1fBjnYLorP11pEor9F+z/EOuizPTyboPU5O/m16fAiv0bl3",
+	"kpjOLnaf+41N/IY3CjeEhrjrnAv2wBLZDj6D6GtIqMy4PsdHXq9R5Sfdw3RpIlgRpDbo03/kqzX8uACH",
+	"AtfGUO1iCnju+YC7OomgXdAToMrKVPpxw0D/+IPdqr7JHOMMwg05ns3G5JEBSQvGeBMAhwI1tknSRO4j",
+	"p2Udc7KAre9fTV9BsqmZhqfmZWNS2w39xDe4aXzSaMOF+WvWbgAksoD6/fPn5EHqZ940KUc177gcxGyK",
+	"B8yaSkJ9gNvCH7rikP9IdMNy0Mev9OeQujWQe0bxbFRWNBl39aZRJCnOrwORRPRgM9sJho4Jho69rraV",
+	"WgcBCR/+2+4TUzdMQZGl2E4ulUmLjAn6kxsCN1J7tgBYezbRvvWxgB9KpzGahz0cerjJCGXsjn9s4gQK",
+	"+yAUKtTrvQBUu3HHbFruiHKu2F6ZZ/ZGD25YRAc2Sb8FJNAxeM1khilBv7pcI6jk2De0/6T8Row+SWa2",
+	"inCMhDQjN64Bq5ycZXyjfW/Lz6vZs3yX5ZrlJ4Mw2q3DK3WO2zLbYs7oqcJSLPBt3YgaqPNBsb8RX8qj",
+	"KDue1rKsBth68Yww38nsIm5TO+hrS8o2zgR41ye4I2buM6FbshVXNT7NGr3qGYW9fw89IiCgKsiARV7Y",
+	"cwYNzHlGQ4fiXYxzJKFsr+d6qyYneIZ8HcdpKTlE6AZWMFWnUgl9AUqwLbYRjKGnejstqW6BBkU5eAkD",
+	"jxuNMkdzCRJCn6C3aS+0wIbDaEdvKS9YLwgl4pqkj6egSPgUKGOUYT0umTjqNfgJBBs1VYNYW3qmPoJz",
+	"q4F5gwJ8BxY3OX71/nj2+uXs9Wz231q6STeZUIkrUWzxsC1qY+RA6IpyoerQk1n1g08URnA1Jmy6mnbv",
+	"AON0vZs7UTNBRT11UHNbcqEVoxeUDE+Y5VPyC1Zlpe27wdEhGrkF+mBBW9cXcqtBPAiNTO9EwszfHOZ5",
+	"cq6wlHwLsl7QzObjS6qlneyHaGHB4z1eLEN7dPGlCs1sShx9Z69698oA5zpQ34lW8DoBpsKZKIq8bEAb",
+	"+maPkpCsMrYJVWxjgXojGbvVRVmC5xa6uRDo+K4U4Mzd17NRd2GfkQ55M3OPhth9YQuVJ1ZpxrXpmsdZ",
+	"q0EP6jWYSu0QMDXtGm7W6WwFfaYIoh3GVIMEwsd+j571oS6eJ0CoIfjmGcBIsh8UtFa6xX3412A93q2y",
+	"4xo4aEfYgtJUdEHDIcL62wJCS29BFc9GfdfTOaKnNTq6lGM9wtbqMjRBW6bo/KrS52xB6iwEDtCLjolq",
+	"sjWai+bW4EGYRhgKBiNb8lUzbM6dbNHseX/Po91Y0nakLHUNFgsuT6Fg799f2+4O0XcE7Hw3C7NFLuqX",
+	"Lzxv8CdbmZwQwoeC1CRhocgJcZdTRYkNW/16MVuDcDw6Z25sRciQsBf++eXP83fnpx/eXJ3+dUzw/w/v",
+	"r64+vJvf/HA2JmeXP5xfnn24vHr/4e3VT5enGsw7u7w5P/nx4uwSPpyfvzvrllIhwScchxab22bySAJn",
+	"mYBoWB3ESt1DRLdddbM1g00k+kmQ7pscMTpvbIsom0z0nnupM3qd/ur7tMe0iGyYBGsjWLqMpJXQi2SZ",
+	"EOiEoXpNNd7+vKdXmshYH34kE33dRC0CW59oaq4nbxMB85lGYE2orOA6iG0cm7S1AjBaSEw5QiwOBdwQ",
+	"SeEH6h7E0uBw4uwtSiP+vE9K/eSwJyn4NEKebSw3smESDdT6p6BLhmkPptGZiQjUhaqgMtVZ6ADs0k9L",
+	"+zkyCjlmrV0WYIDYBb5phO742/ZLm+JGDeFewzjZIW5XH4gZDVfwoc7FQLWXZH4QzBRykz7bqAMh+kd9",
+	"EEOnwJDPDJLu1hcM0WHSR/KX26tLAslhBflhC393VK6bK0ExBIwbantLiPHowXUzR8fTWej7v6hkiR1s",
+	"wEC8N5y8wcu+WQNi8O72EeLHigkmY0x/aCMePoEVE6S8N0J47hImH2ntoAWm4krb7rltNjhH9IQZDucJ",
+	"O4NxYYc6zhoa20PZ0/kMvNqQr29l7oam3ExkPGPilcwnh69fxoMmr49TmuC3cmiPysYDEyfACW3jNnU3",
+	"De6XgyWk/rtiXW//dkSoLrutpk4O1g8bQy0jDZ3o03K8pFTGQPHXBRVPmp/UMI7Vkt39hd3kMK9ua+4W",
+	"A/I1/IG4cm8UNFGO/3HNiD0dg7RtahHvwOJSomaQ97ZQGTXQBKqHbfqEYaGf8Dn4bmDDz5kmDZVmH/bm",
+	"2EjKJJ5KTfgrO8gU9aVpMD5kpWHHSJ7RPrEjmI5uCvDd01VJzaW0m4QnjNsB0hACax1B9eVdrE75ndru",
+	"TSA92GqL7bQ5TzvJld5uchwmOaYQNm46PKVU4Iahug/bhc8rpb4Tc59Ek0Ud4IaCaiMiYk0vqmz2ONyd",
+	"Vn9IQxANjeopYYFGP0pCHVAb9slAYaPrEQNpWtiiQEAvJxwCi3MQCdjOY/lDAf0AwHNHS1b1sP6xi3d2",
+	"YDYNeGoXuCPtxLIFDqEPhpsTmii+Eiyf8A3U4kqjUP0UwQKoN3tbok/axzLGY/U2IgQ2tZlfRysoe5vF",
+	"BzO2+MGPLR7a8TIMXQxCXHHI63qDLq+l1qxUoS1Kd2bwA8rrKwux68Qa2ctqV5zqohtN16Dyyo18mgc9",
+	"CfTq7+s5A3iHSKCvggZPTqHmCcEk9NWDmZEyDvStYh0de1+Qcjq3OkwPe533id7VUAfc4+W73vnJ2/eg",
+	"wrTinm0PyytS71Xtyy8CBpOyMJXQYG/vDcbjTjYO+ZXSi/j/sNH/d7r+UZ2uvtV9zVaXrpQO73RF+HaA",
+	"2aovBG2fZCe+iaYRxXOz5nhfF3xXh621kyGnMYgcrGTZVNEQ3UGzotdMTvz4naXqvYi/dJAv6WEhOyLl",
+	"QVW6dvE5zzUopQ2+1AE9Wa/Pnl6vm1EPy1D/iHAdTmkm3PD1eZvFn9gcEpKgBw6RlbzHyWz7F+b4uJt2",
+	"aGgCcQiVPjHBEA05ILI3vhPYuZO6uUlwPxLCLsnLDfg41Hme2Z6q5wOeiOz/SYVqj26sYPmKgYmd4zVg",
+	"SadpKMoFusHCjYwIclUx4QPWSQmXsrqUSLFRNZqYeSEN2S3tBrQBQl7GMAlRZlqtlhRus0YdvPGDXN5a",
+	"+YAkO9DkbGrBSVBlQSuO8/XT2RThnorWa62DRw/HRzQHKzwyBYoZ8kiPkWhwV7n6xTUCnUdGkrbQrQpk",
+	"thsT2lc2bLNaT67dCQvGgEerxmAyukqGSKctaFnggCvEYVZ1acEfGuiSbmR3Sm7cmzFw7U7oHYFA77Fj",
+	"0u9ZokKh4xR4Qh/nDfhWCJcannxN3jBwy5L8h179nx/JGjbNJEj+F/OGpCvjsI2kxrb4BDMrG1XEYQqH",
+	"ELR6wT8QgDk+dCz6WboxfA3C/vl4jgybYtIgxNqN6RN6MZs5KMAO1tn2PZI4+pvtOBq/sc+rROWqtsok",
+	"rKDsRsGW9S02Gv5BbJiebuLpjWCfKp0NmKaodjfKocG22k4X0HgnKrObfzv6neefj9o50RVLqnTdSKHi",
+	"HoUKkasQnwmfPLapNuYmIRSYwfGCqwFVbifx2uAt/FQDapB9JBbMi6CRA5nQXJjkR1fB4AiowCbCnUgN",
+	"9IVMpRTsBwb65WbvznOXgocvAv36nNlcjgvQ+OF3YUbCeACeAAu1bNg4UI0eCpNMRvXWzXHEo6m6t8rV",
+	"7hFVzRc4E7n1jIWTisP8/PYVLTA5aZ2whA60Yo46wo6+JcN8x22RUO0fEfeGamZA91rn4xpjYjjxHL6j",
+	"RCV70jiue4ldx4f+mKiOXOZJSBGSIx2OVlAB4xMNoQlVE5yP8OPY1naXOKq8w/jsTvdY3TNnsnco/EHm",
+	"+HXVPxp4T4WgeNbd7uGbCkRmSKM/nO+H8Z2e52zRrI4q16LapepjYlEX/KGLLffCQhfax9K5U3raCBVa",
+	"HyRBrngvVwZfdYW7f786aku4sIexDgp2xZZNoXUw53QlSvRAd8Il+X4wRbtr+gD5kO78t51pm2bJRljQ",
+	"QIsCkty8KjlWSZCVwoJhwznF+02f5ivqZLe1lVCG3jeZfJuud08LrlXIADR/albUmYnXbn1NH1hiJH5K",
+	"znpQsYZg29zFkuLKFjseeTZDhxH+PKgbZy24+NUUI34DYThM73sH4VvTl+qJbLcagyc1XBriGHCFcwZg",
+	"8rrZhdBp4hUDRf4FwcKxDti1bihaTlCzxmZs5vz0Xw0WJq0eeow8qMKhFi3Md9N44gYOKEW6uAdN0miC",
+	"cz+ognije8foyyt3oOzesViUOWc4CgEkCqWJKt3wVeSv84t39s0zp7AW/8RtGvAO72n9J0rAUAVhKahT",
+	"tZRfk1BntnRTfBwuK81QuY3+QOlNmW//QPOIXz1BfYm5ex69z3Hi8vmr2ntvTD9hX3bIHEJjsfU+LMDx",
+	"YcXL2XdDE/72iwN0W7gR2ZqKFSyH0JoZOBlzPyj8aroymoP9neXkElidXCDY/y35ErOjtKVrnxKgZi7n",
+	"0EN2zrF8UXnepj+JLN6NlnReJw7fkYxr9ILrL6LBPB62ASkRwj068zRDdri/FkMNW9zm5KdEV3KuW40v",
+	"LGLmdidsmEMDt+lSvzNnuoj6xn/3cc+WfDsWQmonfFdBw1IfXUftI7o107Ro36FGfhHt1RV121DXrsjT",
+	"xa9fMXmju1WLFXE2XuB3jpCwr5AIyA5Y+Hrg1UE1czyO8M1Xyz2GrY14XvYaSdibgcO15Gn43Ya+fccl",
+	"CSiFgwF3om196iIYhAXMo3khb5JpJYf6tMjJmhVVi2+tqcyxPnZARa89O6g7puX7NZUnaionDm3eayZ/",
+	"iyqT9LVD38rZqpCfp00ncBdY8+laoXUIFmZZ6AZzwe8Z+ehTwY82jwmq1izxfSzgY5GGGO5GwVP0Y3uD",
+	"s0EhYTpWJVNBy8q4wOBbL9I9UPLEvGw4g3Ltt6+TQkXt/eflTz1i/6fJU9yBTQI7Az3YTu/1G7K6WzdI",
+	"kXixpvOlK2b8fBEPY+AjP/8vmVR9G4lXAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -16,10 +16,21 @@ const (
 	ComplianceEnrichmentStatusUnmapped ComplianceEnrichmentStatus = "Unmapped"
 )
 
+// Defines values for ComplianceRemediationAction.
+const (
+	ComplianceRemediationActionAllow     ComplianceRemediationAction = "Allow"
+	ComplianceRemediationActionBlock     ComplianceRemediationAction = "Block"
+	ComplianceRemediationActionNotify    ComplianceRemediationAction = "Notify"
+	ComplianceRemediationActionRemediate ComplianceRemediationAction = "Remediate"
+	ComplianceRemediationActionUnknown   ComplianceRemediationAction = "Unknown"
+	ComplianceRemediationActionWaive     ComplianceRemediationAction = "Waive"
+)
+
 // Defines values for ComplianceStatus.
 const (
 	ComplianceStatusCompliant     ComplianceStatus = "Compliant"
 	ComplianceStatusExempt        ComplianceStatus = "Exempt"
+	ComplianceStatusNeedsReview   ComplianceStatus = "Needs Review"
 	ComplianceStatusNonCompliant  ComplianceStatus = "Non-Compliant"
 	ComplianceStatusNotApplicable ComplianceStatus = "Not Applicable"
 	ComplianceStatusUnknown       ComplianceStatus = "Unknown"
@@ -36,25 +47,49 @@ const (
 
 // Defines values for EvidencePolicyEvaluationStatus.
 const (
-	Failed        EvidencePolicyEvaluationStatus = "Failed"
-	NeedsReview   EvidencePolicyEvaluationStatus = "Needs Review"
-	NotApplicable EvidencePolicyEvaluationStatus = "Not Applicable"
-	NotRun        EvidencePolicyEvaluationStatus = "Not Run"
-	Passed        EvidencePolicyEvaluationStatus = "Passed"
-	Unknown       EvidencePolicyEvaluationStatus = "Unknown"
+	EvidencePolicyEvaluationStatusFailed        EvidencePolicyEvaluationStatus = "Failed"
+	EvidencePolicyEvaluationStatusNeedsReview   EvidencePolicyEvaluationStatus = "Needs Review"
+	EvidencePolicyEvaluationStatusNotApplicable EvidencePolicyEvaluationStatus = "Not Applicable"
+	EvidencePolicyEvaluationStatusNotRun        EvidencePolicyEvaluationStatus = "Not Run"
+	EvidencePolicyEvaluationStatusPassed        EvidencePolicyEvaluationStatus = "Passed"
+	EvidencePolicyEvaluationStatusUnknown       EvidencePolicyEvaluationStatus = "Unknown"
 )
 
+// AttributeDefinition A telemetry attribute emitted by complybeacon
+type AttributeDefinition struct {
+	// Description Meaning of the attribute
+	Description string `json:"description"`
+
+	// Key Attribute key
+	Key string `json:"key"`
+}
+
+// CatalogProcedures Procedures loaded for a single catalog
+type CatalogProcedures struct {
+	// CatalogId Unique identifier for the control catalog
+	CatalogId string `json:"catalogId"`
+
+	// Procedures Loaded procedures in sorted ID order
+	Procedures []ProcedureMapping `json:"procedures"`
+}
+
 // Compliance Compliance details from OCSF Security Control Profile.
 type Compliance struct {
 	// Control Security control information for compliance assessment
 	Control ComplianceControl `json:"control"`
 
+	// Controls All security controls matched by the policy rule when results are aggregated across catalogs. The first entry is the primary control.
+	Controls *[]ComplianceControl `json:"controls,omitempty"`
+
 	// EnrichmentStatus Status of the compliance enrichment process: Success, Unmapped, Partial, Unknown, or Skipped.
 	EnrichmentStatus ComplianceEnrichmentStatus `json:"enrichmentStatus"`
 
 	// Frameworks Compliance framework and requirement information
 	Frameworks ComplianceFrameworks `json:"frameworks"`
 
+	// RemediationAction Remediation action recommended by the catalog for the mapped control
+	RemediationAction *ComplianceRemediationAction `json:"remediationAction,omitempty"`
+
 	// Risk Compliance risk assessment information
 	Risk *ComplianceRisk `json:"risk,omitempty"`
 
@@ -65,6 +100,9 @@ type Compliance struct {
 // ComplianceEnrichmentStatus Status of the compliance enrichment process: Success, Unmapped, Partial, Unknown, or Skipped.
 type ComplianceEnrichmentStatus string
 
+// ComplianceRemediationAction Remediation action recommended by the catalog for the mapped control
+type ComplianceRemediationAction string
+
 // ComplianceStatus Compliance status
 type ComplianceStatus string
 
@@ -73,17 +111,29 @@ type ComplianceControl struct {
 	// Applicability Environments or contexts where this control applies
 	Applicability *[]string `json:"applicability,omitempty"`
 
+	// AssessmentProcedure Documentation of the assessment procedure that evaluated the policy rule, as written in the evaluation plan
+	AssessmentProcedure *string `json:"assessmentProcedure,omitempty"`
+
 	// CatalogId Unique identifier for the security control catalog or framework
 	CatalogId string `json:"catalogId"`
 
+	// CatalogVersion Version or revision of the security control catalog used for the mapping
+	CatalogVersion *string `json:"catalogVersion,omitempty"`
+
 	// Category Category or family that the security control belongs to
 	Category string `json:"category"`
 
 	// Id Unique identifier for the security control being assessed
 	Id string `json:"id"`
 
-	// RemediationDescription Description of the recommended remediation strategy for this control
+	// Parameters Parameter values of the security control, keyed by parameter ID
+	Parameters *map[string]string `json:"parameters,omitempty"`
+
+	// RemediationDescription Description of the recommended remediation strategy for this control, from the recommendation of the assessment requirement in the catalog
 	RemediationDescription *string `json:"remediationDescription,omitempty"`
+
+	// Title Human-readable title of the security control, or of the policy rule when the catalog has none
+	Title *string `json:"title,omitempty"`
 }
 
 // ComplianceFrameworks Compliance framework and requirement information
@@ -99,13 +149,66 @@ type ComplianceFrameworks struct {
 type ComplianceRisk struct {
 	// Level Risk level associated with non-compliance
 	Level *ComplianceRiskLevel `json:"level,omitempty"`
+
+	// Score Relative risk score from 0 to 100, weighted by framework importance and control criticality
+	Score *float64 `json:"score,omitempty"`
 }
 
 // ComplianceRiskLevel Risk level associated with non-compliance
 type ComplianceRiskLevel string
 
+// ControlRule A policy rule resolved to a control by a mapper
+type ControlRule struct {
+	// CatalogId Unique identifier for the control catalog
+	CatalogId string `json:"catalogId"`
+
+	// Mapper Mapper plugin ID
+	Mapper string `json:"mapper"`
+
+	// PolicyRuleId Policy rule ID
+	PolicyRuleId string `json:"policyRuleId"`
+}
+
+// ControlRulesResponse Policy rules that assess a control
+type ControlRulesResponse struct {
+	// ControlId Catalog control ID
+	ControlId string `json:"controlId"`
+
+	// Rules Policy rules in sorted mapper, catalog, and rule order
+	Rules []ControlRule `json:"rules"`
+}
+
+// CoverageResponse Policy coverage of the controls in a catalog
+type CoverageResponse struct {
+	// CatalogId Unique identifier for the control catalog
+	CatalogId string `json:"catalogId"`
+
+	// CoveragePercent Share of the catalog's controls that are covered, rounded to one decimal
+	CoveragePercent float64 `json:"coveragePercent"`
+
+	// CoveredControls Sorted IDs of controls assessed by at least one policy rule
+	CoveredControls []string `json:"coveredControls"`
+
+	// UncoveredControls Sorted IDs of controls no policy rule assesses
+	UncoveredControls []string `json:"uncoveredControls"`
+}
+
+// EnginesResponse Policy engines with registered mappers
+type EnginesResponse struct {
+	// Engines Policy engine names handled by a dedicated mapper, in sorted order
+	Engines []string `json:"engines"`
+}
+
 // EnrichmentRequest Request payload for telemetry attribute enrichment
 type EnrichmentRequest struct {
+	// Catalogs Only map the evidence against the catalogs with these IDs, e.g. the catalogs of a single
+	// tenant. Unknown catalog IDs are rejected. When omitted or empty, every loaded catalog is used.
+	Catalogs *[]string `json:"catalogs,omitempty"`
+
+	// DisableFallback Only use the mapper registered for the policy engine. Fallback mappers are skipped, and
+	// evidence from an engine without a registered mapper is reported as unmapped.
+	DisableFallback *bool `json:"disableFallback,omitempty"`
+
 	// Evidence Complete evidence log from policy engines and compliance assessment tools
 	Evidence Evidence `json:"evidence"`
 }
@@ -114,6 +217,9 @@ type EnrichmentRequest struct {
 type EnrichmentResponse struct {
 	// Compliance Compliance details from OCSF Security Control Profile.
 	Compliance Compliance `json:"compliance"`
+
+	// MapperId ID of the mapper plugin that produced the compliance result, such as basic when no plugin is configured for the policy engine.
+	MapperId *string `json:"mapperId,omitempty"`
 }
 
 // Error defines model for Error.
@@ -123,10 +229,19 @@ type Error struct {
 
 	// Message Error message
 	Message string `json:"message"`
+
+	// Reason Machine-readable reason for the error, such as INVALID_BODY, BODY_TOO_LARGE, ENGINE_NOT_FOUND, or ENRICHMENT_FAILED
+	Reason *string `json:"reason,omitempty"`
 }
 
 // Evidence Complete evidence log from policy engines and compliance assessment tools
 type Evidence struct {
+	// ChecksFailed Number of checks within the policy rule that failed
+	ChecksFailed *int `json:"checksFailed,omitempty"`
+
+	// ChecksPassed Number of checks within the policy rule that passed, for policy engines that report per-check results. When check counts are present, the compliance status is derived from them rather than from policyEvaluationStatus.
+	ChecksPassed *int `json:"checksPassed,omitempty"`
+
 	// PolicyEngineName Name of the policy engine that performed the evaluation or enforcement action
 	PolicyEngineName string `json:"policyEngineName"`
 
@@ -136,6 +251,9 @@ type Evidence struct {
 	// PolicyRuleId Unique identifier for the policy rule being evaluated or enforced
 	PolicyRuleId string `json:"policyRuleId"`
 
+	// PolicyRuleName Human-readable name of the policy rule being evaluated or enforced
+	PolicyRuleName *string `json:"policyRuleName,omitempty"`
+
 	// RawData Raw JSON output from the policy engine
 	RawData *map[string]interface{} `json:"rawData,omitempty"`
 
@@ -146,5 +264,112 @@ type Evidence struct {
 // EvidencePolicyEvaluationStatus Result of the policy evaluation
 type EvidencePolicyEvaluationStatus string
 
+// FrameworkSummary Compliance status counts for a single framework
+type FrameworkSummary struct {
+	// Counts Number of evidence results by compliance status
+	Counts map[string]int `json:"counts"`
+
+	// Framework Regulatory or industry standard the counts apply to
+	Framework string `json:"framework"`
+
+	// Total Number of evidence results mapped to the framework
+	Total int `json:"total"`
+}
+
+// MapperPlans Procedures loaded by a single mapper
+type MapperPlans struct {
+	// Catalogs Procedures per catalog in sorted catalog ID order
+	Catalogs []CatalogProcedures `json:"catalogs"`
+
+	// Mapper Mapper plugin ID
+	Mapper string `json:"mapper"`
+}
+
+// PlansResponse Procedures loaded by each mapper, as used to resolve policy rules
+type PlansResponse struct {
+	// Mappers Mappers in sorted ID order
+	Mappers []MapperPlans `json:"mappers"`
+}
+
+// ProcedureMapping Control and requirement a procedure resolves to
+type ProcedureMapping struct {
+	// ControlId Catalog control ID the procedure assesses
+	ControlId string `json:"controlId"`
+
+	// Id Procedure ID, matched against the evidence policy rule ID
+	Id string `json:"id"`
+
+	// RequirementId Requirement ID reported for the procedure
+	RequirementId string `json:"requirementId"`
+}
+
+// ReloadResponse Result of reloading the control catalogs
+type ReloadResponse struct {
+	// Catalogs Number of control catalogs loaded
+	Catalogs int `json:"catalogs"`
+}
+
+// RulesResponse Policy rules compass can map
+type RulesResponse struct {
+	// Complete Whether every mapper listed its rules
+	Complete bool `json:"complete"`
+
+	// Engines Policy rule IDs, in sorted order, mapped by the mapper registered for each policy engine
+	Engines map[string][]string `json:"engines"`
+
+	// FallbackRules Policy rule IDs, in sorted order, mapped by the fallback mappers for evidence from any engine
+	FallbackRules []string `json:"fallbackRules"`
+
+	// UnmappedMapperId Mapper plugin ID reported for evidence from other policy engines that no mapper maps. Omitted when strict engine checking rejects that evidence.
+	UnmappedMapperId *string `json:"unmappedMapperId,omitempty"`
+
+	// UnmappedMapperIds Mapper plugin ID reported for evidence from each policy engine with a registered mapper that no mapper maps
+	UnmappedMapperIds *map[string]string `json:"unmappedMapperIds,omitempty"`
+}
+
+// SchemaResponse Telemetry attribute catalog
+type SchemaResponse struct {
+	// Attributes Attribute definitions in sorted key order
+	Attributes []AttributeDefinition `json:"attributes"`
+}
+
+// SummaryRequest Batch of evidence to summarize
+type SummaryRequest struct {
+	// Catalogs Only map the evidence against the catalogs with these IDs, e.g. the catalogs of a single
+	// tenant. Unknown catalog IDs are rejected. When omitted or empty, every loaded catalog is used.
+	Catalogs *[]string `json:"catalogs,omitempty"`
+
+	// DisableFallback Only use the mapper registered for each policy engine. Fallback mappers are skipped, and
+	// evidence from an engine without a registered mapper is counted as unmapped.
+	DisableFallback *bool `json:"disableFallback,omitempty"`
+
+	// Evidence Evidence logs from policy engines and compliance assessment tools
+	Evidence []Evidence `json:"evidence"`
+}
+
+// SummaryResponse Compliance status counts grouped by framework
+type SummaryResponse struct {
+	// Frameworks Per-framework counts in sorted framework order
+	Frameworks []FrameworkSummary `json:"frameworks"`
+
+	// Unmapped Number of evidence results that did not map to any framework
+	Unmapped int `json:"unmapped"`
+}
+
+// GetV1ControlsIdRulesParams defines parameters for GetV1ControlsIdRules.
+type GetV1ControlsIdRulesParams struct {
+	// CatalogId Only list rules for the control in this catalog
+	CatalogId *string `form:"catalogId,omitempty" json:"catalogId,omitempty"`
+}
+
+// GetV1CoverageParams defines parameters for GetV1Coverage.
+type GetV1CoverageParams struct {
+	// Catalog Unique identifier for the control catalog
+	Catalog string `form:"catalog" json:"catalog"`
+}
+
 // PostV1EnrichJSONRequestBody defines body for PostV1Enrich for application/json ContentType.
 type PostV1EnrichJSONRequestBody = EnrichmentRequest
+
+// PostV1SummaryJSONRequestBody defines body for PostV1Summary for application/json ContentType.
+type PostV1SummaryJSONRequestBody = SummaryRequest
@@ -10,6 +10,7 @@ import (
 
 	"github.com/complytime/complybeacon/compass/cmd/compass/server"
 	"github.com/complytime/complybeacon/compass/internal/logging"
+	"github.com/complytime/complybeacon/compass/mapper"
 	compass "github.com/complytime/complybeacon/compass/service"
 )
 
@@ -18,15 +19,19 @@ func main() {
 	var (
 		port, catalogPath, configPath string
 		logLevel                      string
-		skipTLS                       bool
+		skipTLS, strictEngines, debug bool
+		maxBodyBytes                  int64
 	)
 
 	flag.StringVar(&port, "port", "8080", "Port for HTTP server")
 	flag.BoolVar(&skipTLS, "skip-tls", false, "Run without TLS")
+	flag.BoolVar(&strictEngines, "strict-engines", false, "Reject evidence from policy engines without a registered mapper")
+	flag.BoolVar(&debug, "debug-endpoints", false, "Serve debug endpoints that expose the loaded mapper plans")
+	flag.Int64Var(&maxBodyBytes, "max-body-bytes", 1<<20, "Maximum accepted request body size in bytes")
 	flag.StringVar(&logLevel, "log-level", "info", "Log level: debug|info|warn|error")
 
 	// TODO: This needs to become Layer 3 policy and complete resolution on startup
-	flag.StringVar(&catalogPath, "catalog", "./hack/sampledata/osps.yaml", "Path to Layer 2 catalog")
+	flag.StringVar(&catalogPath, "catalog", "./hack/sampledata/osps.yaml", "Path to a Layer 2 catalog or a directory of catalogs")
 	flag.StringVar(&configPath, "config", "./docs/config.yaml", "Path to compass config file")
 	flag.Parse()
 
@@ -41,15 +46,9 @@ func main() {
 		slog.String("catalog", catalogPath),
 		slog.String("config", configPath),
 		slog.Bool("skip_tls", skipTLS),
+		slog.Int64("max_body_bytes", maxBodyBytes),
 	)
 
-	catalogPath = filepath.Clean(catalogPath)
-	scope, err := server.NewScopeFromCatalogPath(catalogPath)
-	if err != nil {
-		slog.Error("failed to load catalog", "path", catalogPath, "err", err)
-		os.Exit(1)
-	}
-
 	var cfg server.Config
 	configPath = filepath.Clean(configPath)
 	content, err := os.ReadFile(configPath)
@@ -64,15 +63,59 @@ func main() {
 		os.Exit(1)
 	}
 
-	transformers, err := server.NewMapperSet(&cfg)
+	catalogPath = filepath.Clean(catalogPath)
+	scope, err := server.NewScope(catalogPath, &cfg)
+	if err != nil {
+		slog.Error("failed to load catalog", "path", catalogPath, "err", err)
+		os.Exit(1)
+	}
+
+	catalogOpts, err := server.CatalogParameterOptions(catalogPath)
+	if err != nil {
+		slog.Error("failed to load catalog parameters", "path", catalogPath, "err", err)
+		os.Exit(1)
+	}
+
+	transformers, err := server.NewMapperSet(&cfg, catalogOpts...)
 	if err != nil {
 		slog.Error("failed to initialize plugin mappers", "err", err)
 		os.Exit(1)
 	}
 
-	service := compass.NewService(transformers, scope)
+	fallbacks, err := server.NewFallbackMappers(&cfg, transformers)
+	if err != nil {
+		slog.Error("failed to initialize fallback mappers", "err", err)
+		os.Exit(1)
+	}
+
+	basicOpts, err := cfg.FallbackOptions()
+	if err != nil {
+		slog.Error("failed to configure the basic mapper", "err", err)
+		os.Exit(1)
+	}
+	basicOpts = append(catalogOpts, basicOpts...)
+
+	var opts []compass.Option
+	if len(basicOpts) > 0 {
+		opts = append(opts, compass.WithBasicMapperOptions(basicOpts...))
+	}
+	if len(fallbacks) > 0 {
+		opts = append(opts, compass.WithFallbackMappers(fallbacks...))
+	}
+	if strictEngines {
+		opts = append(opts, compass.WithStrictEngines())
+	}
+	if debug {
+		opts = append(opts, compass.WithDebugEndpoints())
+	}
+	if cfg.AdminToken != "" {
+		opts = append(opts, compass.WithCatalogReload(func() (mapper.Scope, error) {
+			return server.NewScope(catalogPath, &cfg)
+		}, cfg.AdminToken))
+	}
+	service := compass.NewService(transformers, scope, opts...)
 
-	s := server.NewGinServer(service, port)
+	s := server.NewGinServer(service, port, maxBodyBytes)
 
 	if skipTLS {
 		slog.Warn("Insecure connections permitted. TLS is highly recommended for production")
@@ -4,19 +4,110 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 
 	"github.com/goccy/go-yaml"
 	"github.com/ossf/gemara/layer2"
 	"github.com/ossf/gemara/layer4"
 
+	"github.com/complytime/complybeacon/compass/api"
 	"github.com/complytime/complybeacon/compass/mapper"
 	"github.com/complytime/complybeacon/compass/mapper/factory"
+	"github.com/complytime/complybeacon/compass/mapper/plugins/basic"
+	"github.com/complytime/complybeacon/compass/mapper/plugins/oscal"
 )
 
+// NewScope loads the Layer 2 catalogs at catalogPath along with the OSCAL
+// catalogs of the configured plugins, so that every catalog a plugin maps
+// against is in the scope shared by the service. Catalog IDs must be unique
+// across both.
+func NewScope(catalogPath string, config *Config) (mapper.Scope, error) {
+	scope, err := NewScopeFromCatalogPath(catalogPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, pluginConf := range config.Plugins {
+		for _, id := range slices.Sorted(maps.Keys(pluginConf.OSCALCatalogs)) {
+			catalog, err := loadOSCALCatalog(pluginConf.OSCALCatalogs[id])
+			if err != nil {
+				return nil, fmt.Errorf("plugin %s: OSCAL catalog %s: %w", pluginConf.Id, id, err)
+			}
+			if _, ok := scope[id]; ok {
+				return nil, fmt.Errorf("plugin %s: duplicate catalog ID %q", pluginConf.Id, id)
+			}
+			scope[id] = catalog.ToLayer2(id)
+		}
+	}
+	return scope, nil
+}
+
+// loadOSCALCatalog loads the OSCAL catalog in JSON format at catalogPath.
+func loadOSCALCatalog(catalogPath string) (oscal.Catalog, error) {
+	cleanedPath := filepath.Clean(catalogPath)
+	slog.Debug("loading OSCAL catalog", slog.String("path", cleanedPath))
+
+	catalogData, err := os.ReadFile(cleanedPath)
+	if err != nil {
+		return oscal.Catalog{}, err
+	}
+	return oscal.ParseCatalog(catalogData)
+}
+
+// NewScopeFromCatalogPath loads the Layer 2 catalog at catalogPath. When
+// catalogPath is a directory, every YAML catalog in it is loaded.
 func NewScopeFromCatalogPath(catalogPath string) (mapper.Scope, error) {
+	files, err := catalogFiles(catalogPath)
+	if err != nil {
+		return nil, err
+	}
+	// Catalog IDs must be unique across the directory.
+	scope := make(mapper.Scope)
+	for _, file := range files {
+		catalogScope, err := newScopeFromCatalogFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("catalog %s: %w", filepath.Base(file), err)
+		}
+		for id, catalog := range catalogScope {
+			if _, ok := scope[id]; ok {
+				return nil, fmt.Errorf("catalog %s: duplicate catalog ID %q", filepath.Base(file), id)
+			}
+			scope[id] = catalog
+		}
+	}
+	return scope, nil
+}
+
+// catalogFiles returns the catalog file at catalogPath, or the YAML catalog
+// files in it when catalogPath is a directory.
+func catalogFiles(catalogPath string) ([]string, error) {
 	cleanedPath := filepath.Clean(catalogPath)
+	info, err := os.Stat(cleanedPath)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{cleanedPath}, nil
+	}
+
+	entries, err := os.ReadDir(cleanedPath)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+		files = append(files, filepath.Join(cleanedPath, entry.Name()))
+	}
+	return files, nil
+}
+
+func newScopeFromCatalogFile(cleanedPath string) (mapper.Scope, error) {
 	slog.Debug("loading catalog", slog.String("path", cleanedPath))
 
 	catalogData, err := os.ReadFile(cleanedPath)
@@ -39,9 +130,81 @@ func NewScopeFromCatalogPath(catalogPath string) (mapper.Scope, error) {
 	}, nil
 }
 
+// catalogParameterDocument decodes the parameter defaults a Layer 2 catalog
+// recommends in the assessment requirements of its controls.
+type catalogParameterDocument struct {
+	Metadata struct {
+		Id string `json:"id"`
+	} `json:"metadata"`
+	ControlFamilies []struct {
+		Controls []struct {
+			Id                     string `json:"id"`
+			AssessmentRequirements []struct {
+				RecommendedParameters []struct {
+					Id      string `json:"id"`
+					Default any    `json:"default"`
+				} `json:"recommended-parameters"`
+			} `json:"assessment-requirements"`
+		} `json:"controls"`
+	} `json:"control-families"`
+}
+
+// CatalogParameterOptions returns mapper options that report the parameter
+// defaults recommended by the Layer 2 catalogs at catalogPath with the
+// controls they belong to. Defaults are read from the recommended-parameters
+// of each control's assessment requirements; parameters without a default
+// are skipped.
+func CatalogParameterOptions(catalogPath string) ([]basic.Option, error) {
+	files, err := catalogFiles(catalogPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []basic.Option
+	for _, file := range files {
+		catalogData, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		var document catalogParameterDocument
+		if err := yaml.Unmarshal(catalogData, &document); err != nil {
+			return nil, fmt.Errorf("catalog %s: %w", filepath.Base(file), err)
+		}
+
+		parameters := make(map[string]map[string]string)
+		for _, family := range document.ControlFamilies {
+			for _, control := range family.Controls {
+				for _, requirement := range control.AssessmentRequirements {
+					for _, parameter := range requirement.RecommendedParameters {
+						if parameter.Id == "" || parameter.Default == nil {
+							continue
+						}
+						if parameters[control.Id] == nil {
+							parameters[control.Id] = make(map[string]string)
+						}
+						parameters[control.Id][parameter.Id] = fmt.Sprint(parameter.Default)
+					}
+				}
+			}
+		}
+		if len(parameters) > 0 {
+			opts = append(opts, basic.WithCatalogParameters(document.Metadata.Id, parameters))
+		}
+	}
+	return opts, nil
+}
+
 type Config struct {
 	Plugins     []PluginConfig `json:"plugins"`
 	Certificate CertConfig     `json:"certConfig"`
+	// AdminToken enables the admin endpoints, which require it as a bearer token.
+	AdminToken string `json:"adminToken"`
+	// FallbackPlugins lists, in order, the IDs of configured plugins tried
+	// after the plugin registered for the policy engine.
+	FallbackPlugins []string `json:"fallbackPlugins"`
+	// UnmappedDefaults configures the unmapped results of the basic mapper
+	// used for policy engines without a plugin.
+	UnmappedDefaults *UnmappedDefaultsConfig `json:"unmappedDefaults,omitempty"`
 }
 
 type CertConfig struct {
@@ -50,16 +213,216 @@ type CertConfig struct {
 }
 
 type PluginConfig struct {
-	Id             string `json:"id"`
-	EvaluationsDir string `json:"evaluations-dir"`
+	// Id is the policy engine the plugin maps evidence for.
+	Id string `json:"id"`
+	// Mapper is the ID of the mapper plugin to use. When unset, the mapper
+	// registered under Id is used, or the basic mapper if there is none.
+	Mapper         string             `json:"mapper,omitempty"`
+	EvaluationsDir string             `json:"evaluations-dir"`
+	RiskScoring    *RiskScoringConfig `json:"risk-scoring,omitempty"`
+	CheckThreshold *float64           `json:"check-threshold,omitempty"`
+	// ControlCheckThresholds overrides CheckThreshold for evidence mapped to
+	// individual controls, keyed by catalog control ID.
+	ControlCheckThresholds map[string]float64 `json:"control-check-thresholds,omitempty"`
+	// UnmappedDefaults configures the plugin's unmapped results.
+	UnmappedDefaults *UnmappedDefaultsConfig `json:"unmapped-defaults,omitempty"`
+	// StatusMapping overrides the compliance status reported for policy
+	// evaluation statuses, e.g. "Not Run: Needs Review".
+	StatusMapping map[string]string `json:"status-mapping,omitempty"`
+	// Aggregate reports every control matched by a policy rule across all
+	// catalogs instead of the first match.
+	Aggregate bool `json:"aggregate,omitempty"`
+	// RemediationActions sets the remediation action recommended for
+	// evidence mapped to each control, keyed by catalog control ID.
+	RemediationActions map[string]string `json:"remediation-actions,omitempty"`
+	// ControlParameters sets the parameter values reported for evidence
+	// mapped to each control, keyed by catalog control ID and then by
+	// parameter ID.
+	ControlParameters map[string]map[string]string `json:"control-parameters,omitempty"`
+	// OSCALCatalogs maps catalog IDs to OSCAL catalogs in JSON format. The
+	// catalogs are added to the shared scope, and the plugin, which must use
+	// the oscal mapper, maps policy rules through their rule-id properties.
+	OSCALCatalogs map[string]string `json:"oscal-catalogs,omitempty"`
 }
 
-func NewMapperSet(config *Config) (mapper.Set, error) {
+// evaluationStatuses and complianceStatuses are the values accepted in a
+// status mapping, and remediationActions those accepted per control.
+var (
+	evaluationStatuses = []api.EvidencePolicyEvaluationStatus{
+		api.EvidencePolicyEvaluationStatusPassed,
+		api.EvidencePolicyEvaluationStatusFailed,
+		api.EvidencePolicyEvaluationStatusNotRun,
+		api.EvidencePolicyEvaluationStatusNotApplicable,
+		api.EvidencePolicyEvaluationStatusNeedsReview,
+		api.EvidencePolicyEvaluationStatusUnknown,
+	}
+	complianceStatuses = []api.ComplianceStatus{
+		api.ComplianceStatusCompliant,
+		api.ComplianceStatusNonCompliant,
+		api.ComplianceStatusNotApplicable,
+		api.ComplianceStatusExempt,
+		api.ComplianceStatusNeedsReview,
+		api.ComplianceStatusUnknown,
+	}
+	remediationActions = []api.ComplianceRemediationAction{
+		api.ComplianceRemediationActionAllow,
+		api.ComplianceRemediationActionBlock,
+		api.ComplianceRemediationActionNotify,
+		api.ComplianceRemediationActionRemediate,
+		api.ComplianceRemediationActionUnknown,
+		api.ComplianceRemediationActionWaive,
+	}
+)
+
+// statusMapping converts the configured status mapping, rejecting statuses
+// outside the API vocabulary.
+func (p PluginConfig) statusMapping() (map[api.EvidencePolicyEvaluationStatus]api.ComplianceStatus, error) {
+	mapping := make(map[api.EvidencePolicyEvaluationStatus]api.ComplianceStatus, len(p.StatusMapping))
+	for evaluation, compliance := range p.StatusMapping {
+		if !slices.Contains(evaluationStatuses, api.EvidencePolicyEvaluationStatus(evaluation)) {
+			return nil, fmt.Errorf("status mapping: unknown evaluation status %q", evaluation)
+		}
+		if !slices.Contains(complianceStatuses, api.ComplianceStatus(compliance)) {
+			return nil, fmt.Errorf("status mapping: unknown compliance status %q", compliance)
+		}
+		mapping[api.EvidencePolicyEvaluationStatus(evaluation)] = api.ComplianceStatus(compliance)
+	}
+	return mapping, nil
+}
+
+// remediationActions converts the configured remediation actions, rejecting
+// actions outside the API vocabulary.
+func (p PluginConfig) remediationActions() (map[string]api.ComplianceRemediationAction, error) {
+	actions := make(map[string]api.ComplianceRemediationAction, len(p.RemediationActions))
+	for controlId, action := range p.RemediationActions {
+		if !slices.Contains(remediationActions, api.ComplianceRemediationAction(action)) {
+			return nil, fmt.Errorf("remediation actions: unknown action %q for control %s", action, controlId)
+		}
+		actions[controlId] = api.ComplianceRemediationAction(action)
+	}
+	return actions, nil
+}
+
+// UnmappedDefaultsConfig sets the catalog ID and category reported for policy
+// rules that do not map to any control.
+type UnmappedDefaultsConfig struct {
+	CatalogId string `json:"catalog-id"`
+	Category  string `json:"category"`
+}
+
+// option returns the mapper option for the unmapped defaults. Both fields
+// are required, since an empty catalog ID cannot be routed.
+func (u *UnmappedDefaultsConfig) option() (basic.Option, error) {
+	if u.CatalogId == "" || u.Category == "" {
+		return nil, errors.New("unmapped defaults require a catalog-id and a category")
+	}
+	return basic.WithUnmappedDefaults(u.CatalogId, u.Category), nil
+}
+
+// FallbackOptions returns the options of the basic mapper used for policy
+// engines without a plugin.
+func (c *Config) FallbackOptions() ([]basic.Option, error) {
+	if c.UnmappedDefaults == nil {
+		return nil, nil
+	}
+	opt, err := c.UnmappedDefaults.option()
+	if err != nil {
+		return nil, err
+	}
+	return []basic.Option{opt}, nil
+}
+
+// RiskScoringConfig enables the compliance risk score for a plugin. Weights
+// range from 0 to 1; frameworks without a weight use the default weight.
+type RiskScoringConfig struct {
+	FrameworkWeights map[string]float64 `json:"framework-weights"`
+	DefaultWeight    float64            `json:"default-weight"`
+	ControlWeights   map[string]float64 `json:"control-weights"`
+}
+
+// mapperID returns the ID of the mapper plugin to build for the configuration.
+func (p PluginConfig) mapperID() mapper.ID {
+	if p.Mapper != "" {
+		return mapper.ID(p.Mapper)
+	}
+	if id := mapper.ID(p.Id); factory.Registered(id) {
+		return id
+	}
+	return basic.ID
+}
+
+// options returns the mapper options for the plugin configuration.
+func (p PluginConfig) options() ([]basic.Option, error) {
+	var opts []basic.Option
+	if p.RiskScoring != nil {
+		opts = append(opts, basic.WithRiskScoring(basic.RiskScoring{
+			FrameworkWeights: p.RiskScoring.FrameworkWeights,
+			DefaultWeight:    p.RiskScoring.DefaultWeight,
+			ControlWeights:   p.RiskScoring.ControlWeights,
+		}))
+	}
+	if p.CheckThreshold != nil {
+		opts = append(opts, basic.WithCheckThreshold(*p.CheckThreshold))
+	}
+	if len(p.ControlCheckThresholds) > 0 {
+		opts = append(opts, basic.WithControlCheckThresholds(p.ControlCheckThresholds))
+	}
+	if p.UnmappedDefaults != nil {
+		opt, err := p.UnmappedDefaults.option()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, opt)
+	}
+	if len(p.StatusMapping) > 0 {
+		mapping, err := p.statusMapping()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, basic.WithStatusMapping(mapping))
+	}
+	if p.Aggregate {
+		opts = append(opts, basic.WithAggregation())
+	}
+	if len(p.RemediationActions) > 0 {
+		actions, err := p.remediationActions()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, basic.WithRemediationActions(actions))
+	}
+	if len(p.ControlParameters) > 0 {
+		opts = append(opts, basic.WithControlParameters(p.ControlParameters))
+	}
+	return opts, nil
+}
+
+// NewMapperSet builds the mappers of the configured plugins. opts, such as
+// those from CatalogParameterOptions, apply to every plugin ahead of its own
+// options.
+func NewMapperSet(config *Config, opts ...basic.Option) (mapper.Set, error) {
 	pluginSet := make(mapper.Set)
 	slog.Debug("loading plugins", slog.Int("count", len(config.Plugins)))
 
 	for _, pluginConf := range config.Plugins {
 		transformerId := mapper.ID(pluginConf.Id)
+		pluginOpts, err := pluginConf.options()
+		if err != nil {
+			return pluginSet, fmt.Errorf("plugin %s: %w", pluginConf.Id, err)
+		}
+		mpr, err := factory.NewMapper(pluginConf.mapperID(), append(slices.Clone(opts), pluginOpts...)...)
+		if err != nil {
+			return pluginSet, fmt.Errorf("plugin %s: %w", pluginConf.Id, err)
+		}
+		if len(pluginConf.OSCALCatalogs) > 0 {
+			if err := addOSCALCatalogs(mpr, pluginConf.OSCALCatalogs); err != nil {
+				return pluginSet, fmt.Errorf("plugin %s: %w", pluginConf.Id, err)
+			}
+			if pluginConf.EvaluationsDir == "" {
+				pluginSet[transformerId] = mpr
+				continue
+			}
+		}
 		if pluginConf.EvaluationsDir == "" {
 			slog.Info("plugin has no evaluations; skipping",
 				slog.String("plugin_id", string(transformerId)),
@@ -79,7 +442,7 @@ func NewMapperSet(config *Config) (mapper.Set, error) {
 			return pluginSet, fmt.Errorf("evaluations directory %s for plugin %s is not a directory", pluginConf.EvaluationsDir, pluginConf.Id)
 		}
 
-		tfmr, err := NewMapperFromDir(transformerId, pluginConf.EvaluationsDir)
+		tfmr, err := NewMapperFromDir(mpr, pluginConf.EvaluationsDir)
 		if err != nil {
 			return pluginSet, fmt.Errorf("unable to load configuration for %s: %w", pluginConf.Id, err)
 		}
@@ -89,8 +452,40 @@ func NewMapperSet(config *Config) (mapper.Set, error) {
 	return pluginSet, nil
 }
 
-func NewMapperFromDir(pluginID mapper.ID, evaluationsPath string) (mapper.Mapper, error) {
-	mpr := factory.MapperByID(pluginID)
+// addOSCALCatalogs adds the plans and parameters of the OSCAL catalogs,
+// keyed by catalog ID, to mpr, which must be an OSCAL mapper.
+func addOSCALCatalogs(mpr mapper.Mapper, catalogPaths map[string]string) error {
+	oscalMapper, ok := mpr.(*oscal.Mapper)
+	if !ok {
+		return fmt.Errorf("oscal-catalogs requires the %s mapper, not %s", oscal.ID, mpr.PluginName())
+	}
+	for _, id := range slices.Sorted(maps.Keys(catalogPaths)) {
+		catalog, err := loadOSCALCatalog(catalogPaths[id])
+		if err != nil {
+			return fmt.Errorf("OSCAL catalog %s: %w", id, err)
+		}
+		oscalMapper.AddCatalog(id, catalog)
+	}
+	return nil
+}
+
+// NewFallbackMappers returns the mappers of the fallback plugins in config,
+// in order, from the loaded set. A fallback that names a plugin that is not
+// loaded is an error.
+func NewFallbackMappers(config *Config, set mapper.Set) ([]mapper.Mapper, error) {
+	fallbacks := make([]mapper.Mapper, 0, len(config.FallbackPlugins))
+	for _, id := range config.FallbackPlugins {
+		mpr, ok := set[mapper.ID(id)]
+		if !ok {
+			return nil, fmt.Errorf("fallback plugin %s is not a loaded plugin", id)
+		}
+		fallbacks = append(fallbacks, mpr)
+	}
+	return fallbacks, nil
+}
+
+// NewMapperFromDir adds the evaluation plans in evaluationsPath to mpr.
+func NewMapperFromDir(mpr mapper.Mapper, evaluationsPath string) (mapper.Mapper, error) {
 	err := filepath.Walk(evaluationsPath, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
@@ -124,8 +519,13 @@ func NewMapperFromDir(pluginID mapper.ID, evaluationsPath string) (mapper.Mapper
 	if err != nil {
 		return mpr, err
 	}
+	if validator, ok := mpr.(interface{ Validate() error }); ok {
+		if err := validator.Validate(); err != nil {
+			return mpr, fmt.Errorf("invalid evaluations in %s: %w", evaluationsPath, err)
+		}
+	}
 	slog.Info("plugin evaluations loaded",
-		slog.String("plugin_id", string(pluginID)),
+		slog.String("mapper_id", string(mpr.PluginName())),
 		slog.String("dir", evaluationsPath),
 	)
 	return mpr, nil
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ossf/gemara/layer4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/complytime/complybeacon/compass/api"
+	"github.com/complytime/complybeacon/compass/mapper"
+	"github.com/complytime/complybeacon/compass/mapper/plugins/basic"
+)
+
+const (
+	testCatalogPath      = "../../../../hack/sampledata/osps.yaml"
+	testOSCALCatalogPath = "../../../mapper/plugins/oscal/testdata/catalog.json"
+)
+
+func TestNewScopeWithOSCALCatalogs(t *testing.T) {
+	config := &Config{
+		Plugins: []PluginConfig{
+			{Id: "opa", Mapper: "oscal", OSCALCatalogs: map[string]string{"EXAMPLE": testOSCALCatalogPath}},
+		},
+	}
+
+	scope, err := NewScope(testCatalogPath, config)
+	require.NoError(t, err)
+	assert.Contains(t, scope, "OSPS-B")
+	require.Contains(t, scope, "EXAMPLE")
+	assert.Equal(t, "Example Security Controls", scope["EXAMPLE"].Metadata.Title)
+
+	set, err := NewMapperSet(config)
+	require.NoError(t, err)
+	require.Contains(t, set, mapper.ID("opa"))
+
+	compliance, err := set["opa"].Map(api.Evidence{
+		PolicyRuleId:           "accounts-reviewed",
+		PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusFailed,
+	}, scope)
+	require.NoError(t, err)
+	assert.Equal(t, api.ComplianceEnrichmentStatusSuccess, compliance.EnrichmentStatus)
+	assert.Equal(t, "EXAMPLE", compliance.Control.CatalogId)
+	assert.Equal(t, "AC-2", compliance.Control.Id)
+}
+
+func TestNewScopeWithOSCALCatalogsErrors(t *testing.T) {
+	invalid := filepath.Join(t.TempDir(), "invalid.json")
+	require.NoError(t, os.WriteFile(invalid, []byte(`{"profile": {}}`), 0o600))
+
+	tests := []struct {
+		name    string
+		plugin  PluginConfig
+		wantErr string
+	}{
+		{
+			name:    "duplicate catalog ID",
+			plugin:  PluginConfig{Id: "opa", Mapper: "oscal", OSCALCatalogs: map[string]string{"OSPS-B": testOSCALCatalogPath}},
+			wantErr: `duplicate catalog ID "OSPS-B"`,
+		},
+		{
+			name:    "invalid catalog",
+			plugin:  PluginConfig{Id: "opa", Mapper: "oscal", OSCALCatalogs: map[string]string{"EXAMPLE": invalid}},
+			wantErr: "does not contain an OSCAL catalog",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewScope(testCatalogPath, &Config{Plugins: []PluginConfig{tt.plugin}})
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+
+	t.Run("requires the oscal mapper", func(t *testing.T) {
+		_, err := NewMapperSet(&Config{
+			Plugins: []PluginConfig{
+				{Id: "opa", Mapper: "basic", OSCALCatalogs: map[string]string{"EXAMPLE": testOSCALCatalogPath}},
+			},
+		})
+		assert.ErrorContains(t, err, "oscal-catalogs requires the oscal mapper")
+	})
+}
+
+func TestCatalogParameterOptions(t *testing.T) {
+	opts, err := CatalogParameterOptions(testCatalogPath)
+	require.NoError(t, err)
+	scope, err := NewScopeFromCatalogPath(testCatalogPath)
+	require.NoError(t, err)
+
+	evidence := api.Evidence{
+		PolicyEngineName:       "test-policy-engine",
+		PolicyRuleId:           "branch-protection",
+		PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
+	}
+	plan := layer4.AssessmentPlan{
+		Control: layer4.Mapping{ReferenceId: "OSPS-B", EntryId: "OSPS-QA-07"},
+		Assessments: []layer4.Assessment{
+			{
+				Requirement: layer4.Mapping{ReferenceId: "OSPS-B", EntryId: "OSPS-QA-07.01"},
+				Procedures:  []layer4.AssessmentProcedure{{Id: "branch-protection"}},
+			},
+		},
+	}
+
+	t.Run("catalog defaults", func(t *testing.T) {
+		basicMapper := basic.NewBasicMapper(opts...)
+		basicMapper.AddEvaluationPlan("OSPS-B", plan)
+
+		compliance, err := basicMapper.Map(evidence, scope)
+		require.NoError(t, err)
+		assert.Equal(t, &map[string]string{"main_branch_min_approvals": "1"}, compliance.Control.Parameters)
+	})
+
+	t.Run("configured parameters override catalog defaults", func(t *testing.T) {
+		set, err := NewMapperSet(&Config{
+			Plugins: []PluginConfig{
+				{
+					Id:                "test-policy-engine",
+					EvaluationsDir:    t.TempDir(),
+					ControlParameters: map[string]map[string]string{"OSPS-QA-07": {"main_branch_min_approvals": "2"}},
+				},
+			},
+		}, opts...)
+		require.NoError(t, err)
+		set["test-policy-engine"].AddEvaluationPlan("OSPS-B", plan)
+
+		compliance, err := set["test-policy-engine"].Map(evidence, scope)
+		require.NoError(t, err)
+		assert.Equal(t, &map[string]string{"main_branch_min_approvals": "2"}, compliance.Control.Parameters)
+	})
+}
@@ -16,7 +16,9 @@ import (
 	compass "github.com/complytime/complybeacon/compass/service"
 )
 
-func NewGinServer(service *compass.Service, port string) *http.Server {
+// NewGinServer creates the compass HTTP server. Request bodies larger than
+// maxBodyBytes are rejected before they are validated or bound.
+func NewGinServer(service *compass.Service, port string, maxBodyBytes int64) *http.Server {
 	swagger, err := api.GetSwagger()
 	if err != nil {
 		log.Fatalf("Error loading swagger spec\n: %s", err)
@@ -29,8 +31,9 @@ func NewGinServer(service *compass.Service, port string) *http.Server {
 	r := gin.New()
 	r.Use(gin.Recovery())
 	r.Use(requestid.New(), httpmw.AccessLogger())
+	r.Use(httpmw.BodyLimit(maxBodyBytes))
 
-	r.Use(middleware.OapiRequestValidator(swagger))
+	r.Use(validateRequests(middleware.OapiRequestValidator(swagger)))
 
 	api.RegisterHandlers(r, service)
 
@@ -43,6 +46,19 @@ func NewGinServer(service *compass.Service, port string) *http.Server {
 	return s
 }
 
+// validateRequests runs the OpenAPI request validator on requests whose body
+// the service can decode. Bodies of any other content type skip validation so
+// the handlers reject them with 415 Unsupported Media Type rather than a
+// validation error.
+func validateRequests(validator gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.ContentLength != 0 && !compass.SupportsContentType(c.ContentType()) {
+			return
+		}
+		validator(c)
+	}
+}
+
 func SetupTLS(server *http.Server, config Config) (string, string) {
 	// TODO: Allow loosening here through configuration
 	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS13}
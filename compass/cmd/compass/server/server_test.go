@@ -0,0 +1,103 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/complytime/complybeacon/compass/api"
+	"github.com/complytime/complybeacon/compass/mapper"
+	compass "github.com/complytime/complybeacon/compass/service"
+)
+
+// serve sends a request through the full compass router, including the
+// OpenAPI request validator.
+func serve(t *testing.T, method, path, contentType string, body []byte) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	service := compass.NewService(make(mapper.Set), make(mapper.Scope))
+	s := NewGinServer(service, "0", 1<<20)
+
+	req := httptest.NewRequest(method, path, bytes.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	w := httptest.NewRecorder()
+	s.Handler.ServeHTTP(w, req)
+	return w
+}
+
+func TestNewGinServerSummaryContentTypes(t *testing.T) {
+	yamlBody := []byte(`evidence:
+  - policyEngineName: test-policy-engine
+    policyRuleId: AC-1
+    policyEvaluationStatus: Passed
+    timestamp: 2025-01-05T12:30:00Z
+`)
+	jsonBody := []byte(`{"evidence": [{"policyEngineName": "test-policy-engine", "policyRuleId": "AC-1", "policyEvaluationStatus": "Passed", "timestamp": "2025-01-05T12:30:00Z"}]}`)
+
+	tests := []struct {
+		name        string
+		body        []byte
+		contentType string
+	}{
+		{name: "JSON", body: jsonBody, contentType: "application/json"},
+		{name: "YAML", body: yamlBody, contentType: "application/yaml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serve(t, http.MethodPost, "/v1/summary", tt.contentType, tt.body)
+
+			require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+			var response api.SummaryResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, 1, response.Unmapped)
+		})
+	}
+}
+
+func TestNewGinServerEnrichContentTypes(t *testing.T) {
+	yamlBody := []byte(`evidence:
+  policyEngineName: test-policy-engine
+  policyRuleId: AC-1
+  policyEvaluationStatus: Passed
+  timestamp: 2025-01-05T12:30:00Z
+`)
+	jsonBody := []byte(`{"evidence": {"policyEngineName": "test-policy-engine", "policyRuleId": "AC-1", "policyEvaluationStatus": "Passed", "timestamp": "2025-01-05T12:30:00Z"}}`)
+
+	tests := []struct {
+		name         string
+		body         []byte
+		contentType  string
+		expectedCode int
+	}{
+		{name: "JSON", body: jsonBody, contentType: "application/json", expectedCode: http.StatusOK},
+		{name: "YAML", body: yamlBody, contentType: "application/yaml", expectedCode: http.StatusOK},
+		{name: "undeclared YAML", body: yamlBody, contentType: "application/x-yaml", expectedCode: http.StatusUnsupportedMediaType},
+		{name: "plain text", body: jsonBody, contentType: "text/plain", expectedCode: http.StatusUnsupportedMediaType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serve(t, http.MethodPost, "/v1/enrich", tt.contentType, tt.body)
+
+			require.Equal(t, tt.expectedCode, w.Code, w.Body.String())
+			if tt.expectedCode != http.StatusOK {
+				var apiErr api.Error
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &apiErr))
+				require.NotNil(t, apiErr.Reason)
+				assert.Equal(t, compass.ReasonUnsupportedMediaType, *apiErr.Reason)
+				return
+			}
+			var response api.EnrichmentResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, api.ComplianceEnrichmentStatusUnmapped, response.Compliance.EnrichmentStatus)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	requestid "github.com/gin-contrib/requestid"
+	"github.com/gin-gonic/gin"
+
+	"github.com/complytime/complybeacon/compass/api"
+	compass "github.com/complytime/complybeacon/compass/service"
+)
+
+// BodyLimit rejects requests whose body exceeds limit bytes with a 413.
+// The body is read up front, so requests without a Content-Length are
+// bounded before request validation or binding read them.
+func BodyLimit(limit int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Body == nil || c.Request.Body == http.NoBody {
+			c.Next()
+			return
+		}
+
+		if c.Request.ContentLength > limit {
+			rejectBody(c, limit)
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(c.Request.Body, limit+1))
+		_ = c.Request.Body.Close()
+		if err != nil {
+			slog.Warn("failed to read request body",
+				slog.String("request_id", requestid.Get(c)),
+				slog.String("error", err.Error()),
+			)
+			reason := compass.ReasonInvalidBody
+			c.AbortWithStatusJSON(http.StatusBadRequest, api.Error{
+				Code:    http.StatusBadRequest,
+				Message: "Unable to read request body",
+				Reason:  &reason,
+			})
+			return
+		}
+		if int64(len(body)) > limit {
+			rejectBody(c, limit)
+			return
+		}
+
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		c.Next()
+	}
+}
+
+// rejectBody aborts the request with a 413 api.Error.
+func rejectBody(c *gin.Context, limit int64) {
+	slog.Warn("request body too large",
+		slog.String("request_id", requestid.Get(c)),
+		slog.Int64("limit", limit),
+	)
+	reason := compass.ReasonBodyTooLarge
+	c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, api.Error{
+		Code:    http.StatusRequestEntityTooLarge,
+		Message: fmt.Sprintf("Request body exceeds the %d byte limit", limit),
+		Reason:  &reason,
+	})
+}
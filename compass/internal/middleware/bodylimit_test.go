@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/complytime/complybeacon/compass/api"
+	compass "github.com/complytime/complybeacon/compass/service"
+)
+
+func TestBodyLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name          string
+		body          string
+		unknownLength bool
+		expectedCode  int
+	}{
+		{
+			name:         "body within limit",
+			body:         strings.Repeat("a", 16),
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "oversized body with content length",
+			body:         strings.Repeat("a", 17),
+			expectedCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:          "oversized body without content length",
+			body:          strings.Repeat("a", 64),
+			unknownLength: true,
+			expectedCode:  http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received string
+			r := gin.New()
+			r.Use(BodyLimit(16))
+			r.POST("/v1/enrich", func(c *gin.Context) {
+				body, err := io.ReadAll(c.Request.Body)
+				require.NoError(t, err)
+				received = string(body)
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/enrich", strings.NewReader(tt.body))
+			if tt.unknownLength {
+				req.ContentLength = -1
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			if tt.expectedCode == http.StatusOK {
+				assert.Equal(t, tt.body, received, "handler should see the full body")
+				return
+			}
+
+			assert.Empty(t, received, "handler should not run for oversized bodies")
+			var apiErr api.Error
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &apiErr))
+			assert.Equal(t, int32(http.StatusRequestEntityTooLarge), apiErr.Code)
+			require.NotNil(t, apiErr.Reason)
+			assert.Equal(t, compass.ReasonBodyTooLarge, *apiErr.Reason)
+		})
+	}
+}
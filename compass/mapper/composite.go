@@ -0,0 +1,75 @@
+package mapper
+
+import (
+	"github.com/ossf/gemara/layer4"
+
+	"github.com/complytime/complybeacon/compass/api"
+)
+
+// CompositeID is the plugin name reported by a CompositeMapper.
+const CompositeID ID = "composite"
+
+// CompositeMapper is a Mapper that delegates to an ordered list of mappers.
+type CompositeMapper struct {
+	mappers []Mapper
+}
+
+// NewCompositeMapper returns a CompositeMapper that tries mappers in order.
+func NewCompositeMapper(mappers ...Mapper) *CompositeMapper {
+	return &CompositeMapper{mappers: mappers}
+}
+
+func (c *CompositeMapper) PluginName() ID {
+	return CompositeID
+}
+
+// Map returns the first result that is not unmapped. When every mapper
+// leaves the evidence unmapped, the last result is returned, and without any
+// mappers the evidence is reported as unmapped. A mapper error stops the
+// delegation and is returned as is.
+func (c *CompositeMapper) Map(evidence api.Evidence, scope Scope) (api.Compliance, error) {
+	compliance, _, err := c.MapWithID(evidence, scope)
+	return compliance, err
+}
+
+// MapWithID maps the evidence like Map and also returns the ID of the mapper
+// that produced the result, or of the mapper that failed. Without any
+// mappers, CompositeID is returned.
+func (c *CompositeMapper) MapWithID(evidence api.Evidence, scope Scope) (api.Compliance, ID, error) {
+	if len(c.mappers) == 0 {
+		return unmapped(), CompositeID, nil
+	}
+	var compliance api.Compliance
+	var id ID
+	for _, m := range c.mappers {
+		id = m.PluginName()
+		var err error
+		compliance, err = m.Map(evidence, scope)
+		if err != nil {
+			return api.Compliance{}, id, err
+		}
+		if compliance.EnrichmentStatus != api.ComplianceEnrichmentStatusUnmapped {
+			return compliance, id, nil
+		}
+	}
+	return compliance, id, nil
+}
+
+// unmapped returns the result for evidence that no mapper could map.
+func unmapped() api.Compliance {
+	return api.Compliance{
+		Status:           api.ComplianceStatusUnknown,
+		EnrichmentStatus: api.ComplianceEnrichmentStatusUnmapped,
+		Frameworks: api.ComplianceFrameworks{
+			Frameworks:   []string{},
+			Requirements: []string{},
+		},
+	}
+}
+
+// AddEvaluationPlan adds the plans to every delegate mapper.
+func (c *CompositeMapper) AddEvaluationPlan(catalogId string, plans ...layer4.AssessmentPlan) {
+	for _, m := range c.mappers {
+		m.AddEvaluationPlan(catalogId, plans...)
+	}
+}
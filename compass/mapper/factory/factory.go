@@ -1,10 +1,35 @@
 package factory
 
 import (
+	"fmt"
+
 	"github.com/complytime/complybeacon/compass/mapper"
 	"github.com/complytime/complybeacon/compass/mapper/plugins/basic"
+	"github.com/complytime/complybeacon/compass/mapper/plugins/cel"
+	"github.com/complytime/complybeacon/compass/mapper/plugins/oscal"
 )
 
-func MapperByID(_ mapper.ID) mapper.Mapper {
-	return basic.NewBasicMapper()
+// registry holds the constructors of all known mapper plugins by ID.
+// Options configure the basic mapper every plugin builds on.
+var registry = map[mapper.ID]func(...basic.Option) mapper.Mapper{
+	basic.ID: func(opts ...basic.Option) mapper.Mapper { return basic.NewBasicMapper(opts...) },
+	cel.ID:   func(opts ...basic.Option) mapper.Mapper { return cel.NewCELMapper(opts...) },
+	oscal.ID: func(opts ...basic.Option) mapper.Mapper { return oscal.NewOSCALMapper(opts...) },
+}
+
+// Registered reports whether a mapper plugin is registered under id.
+func Registered(id mapper.ID) bool {
+	_, ok := registry[id]
+	return ok
+}
+
+// NewMapper returns the mapper plugin registered under id configured with
+// opts. An unknown plugin ID is an error so misconfiguration is caught at
+// startup.
+func NewMapper(id mapper.ID, opts ...basic.Option) (mapper.Mapper, error) {
+	newMapper, ok := registry[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown mapper plugin %q", id)
+	}
+	return newMapper(opts...), nil
 }
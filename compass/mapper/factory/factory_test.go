@@ -0,0 +1,50 @@
+package factory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/complytime/complybeacon/compass/mapper"
+	"github.com/complytime/complybeacon/compass/mapper/plugins/basic"
+	"github.com/complytime/complybeacon/compass/mapper/plugins/cel"
+	"github.com/complytime/complybeacon/compass/mapper/plugins/oscal"
+)
+
+func TestNewMapper(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          mapper.ID
+		expectedErr string
+	}{
+		{name: "basic plugin", id: basic.ID},
+		{name: "cel plugin", id: cel.ID},
+		{name: "oscal plugin", id: oscal.ID},
+		{
+			name:        "unknown plugin",
+			id:          "kyverno",
+			expectedErr: `unknown mapper plugin "kyverno"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := NewMapper(tt.id)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, m)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.id, m.PluginName())
+		})
+	}
+}
+
+func TestRegistered(t *testing.T) {
+	assert.True(t, Registered(basic.ID))
+	assert.True(t, Registered(cel.ID))
+	assert.True(t, Registered(oscal.ID))
+	assert.False(t, Registered("kyverno"))
+}
@@ -1,6 +1,9 @@
 package mapper
 
 import (
+	"maps"
+	"slices"
+
 	"github.com/ossf/gemara/layer2"
 	"github.com/ossf/gemara/layer4"
 
@@ -9,9 +12,13 @@ import (
 
 // Mapper defines a set of methods a plugin must implement for
 // mapper RawEvidence into a `gemara` AssessmentPlan.
+//
+// Map returns an error when the evidence cannot be processed at all.
+// Evidence that is valid but has no matching plan is not an error and
+// should be reported with an unmapped enrichment status instead.
 type Mapper interface {
 	PluginName() ID
-	Map(evidence api.Evidence, scope Scope) api.Compliance
+	Map(evidence api.Evidence, scope Scope) (api.Compliance, error)
 	AddEvaluationPlan(catalogId string, plans ...layer4.AssessmentPlan)
 }
 
@@ -26,6 +33,11 @@ func NewID(id string) ID {
 // Set defines Transformers by ID
 type Set map[ID]Mapper
 
+// IDs returns the IDs of all mappers in the set in sorted order.
+func (s Set) IDs() []ID {
+	return slices.Sorted(maps.Keys(s))
+}
+
 // Scope defined in scope Layer2 Catalogs by the
 // catalog ID
 type Scope map[string]layer2.Catalog
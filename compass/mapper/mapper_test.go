@@ -7,6 +7,7 @@ import (
 	"github.com/ossf/gemara/layer2"
 	"github.com/ossf/gemara/layer4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/complytime/complybeacon/compass/api"
 )
@@ -21,7 +22,7 @@ func (m *mockMapper) PluginName() ID {
 	return m.id
 }
 
-func (m *mockMapper) Map(evidence api.Evidence, scope Scope) api.Compliance {
+func (m *mockMapper) Map(evidence api.Evidence, scope Scope) (api.Compliance, error) {
 	return api.Compliance{
 		Control: api.ComplianceControl{
 			Id:        evidence.PolicyRuleId,
@@ -34,7 +35,7 @@ func (m *mockMapper) Map(evidence api.Evidence, scope Scope) api.Compliance {
 		},
 		Status:           api.ComplianceStatusCompliant,
 		EnrichmentStatus: api.ComplianceEnrichmentStatusSuccess,
-	}
+	}, nil
 }
 
 func (m *mockMapper) AddEvaluationPlan(catalogId string, plans ...layer4.AssessmentPlan) {
@@ -103,6 +104,17 @@ func TestSet(t *testing.T) {
 	assert.Contains(t, set, ID("control-mapper"))
 }
 
+func TestSetIDs(t *testing.T) {
+	assert.Empty(t, make(Set).IDs())
+
+	set := Set{
+		"policy-mapper":  &mockMapper{id: "policy-mapper"},
+		"control-mapper": &mockMapper{id: "control-mapper"},
+		"audit-mapper":   &mockMapper{id: "audit-mapper"},
+	}
+	assert.Equal(t, []ID{"audit-mapper", "control-mapper", "policy-mapper"}, set.IDs())
+}
+
 func TestScope(t *testing.T) {
 	scope := make(Scope)
 
@@ -153,12 +165,13 @@ func TestMapperInterfaceAndIDType(t *testing.T) {
 		evidence := api.Evidence{
 			PolicyEngineName:       "test-policy-engine",
 			PolicyRuleId:           "AC-1",
-			PolicyEvaluationStatus: api.Passed,
+			PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
 			Timestamp:              time.Now(),
 		}
 		scope := make(Scope)
 
-		compliance := mapper.Map(evidence, scope)
+		compliance, err := mapper.Map(evidence, scope)
+		require.NoError(t, err)
 		assert.Equal(t, "test-catalog", compliance.Control.CatalogId)
 		assert.Equal(t, "AC-1", compliance.Control.Id)
 		assert.Equal(t, api.ComplianceStatusCompliant, compliance.Status)
@@ -171,3 +184,96 @@ func TestMapperInterfaceAndIDType(t *testing.T) {
 		assert.Contains(t, mapper.plans, "test-catalog")
 	})
 }
+
+// statusMapper returns a fixed enrichment status and records its calls.
+type statusMapper struct {
+	mockMapper
+	status api.ComplianceEnrichmentStatus
+	err    error
+	calls  int
+}
+
+func (m *statusMapper) Map(evidence api.Evidence, scope Scope) (api.Compliance, error) {
+	m.calls++
+	if m.err != nil {
+		return api.Compliance{}, m.err
+	}
+	compliance, _ := m.mockMapper.Map(evidence, scope)
+	compliance.Control.CatalogId = string(m.id)
+	compliance.EnrichmentStatus = m.status
+	return compliance, nil
+}
+
+func TestCompositeMapper(t *testing.T) {
+	evidence := api.Evidence{PolicyRuleId: "AC-1", Timestamp: time.Now()}
+
+	t.Run("first mapped result wins", func(t *testing.T) {
+		first := &statusMapper{mockMapper: mockMapper{id: "first"}, status: api.ComplianceEnrichmentStatusUnmapped}
+		second := &statusMapper{mockMapper: mockMapper{id: "second"}, status: api.ComplianceEnrichmentStatusSuccess}
+		third := &statusMapper{mockMapper: mockMapper{id: "third"}, status: api.ComplianceEnrichmentStatusSuccess}
+
+		composite := NewCompositeMapper(first, second, third)
+		var _ Mapper = composite
+		assert.Equal(t, CompositeID, composite.PluginName())
+
+		compliance, err := composite.Map(evidence, make(Scope))
+		require.NoError(t, err)
+		assert.Equal(t, "second", compliance.Control.CatalogId)
+		assert.Equal(t, 1, first.calls)
+		assert.Equal(t, 1, second.calls)
+		assert.Equal(t, 0, third.calls)
+	})
+
+	t.Run("all unmapped returns last result", func(t *testing.T) {
+		first := &statusMapper{mockMapper: mockMapper{id: "first"}, status: api.ComplianceEnrichmentStatusUnmapped}
+		last := &statusMapper{mockMapper: mockMapper{id: "last"}, status: api.ComplianceEnrichmentStatusUnmapped}
+
+		compliance, err := NewCompositeMapper(first, last).Map(evidence, make(Scope))
+		require.NoError(t, err)
+		assert.Equal(t, "last", compliance.Control.CatalogId)
+		assert.Equal(t, api.ComplianceEnrichmentStatusUnmapped, compliance.EnrichmentStatus)
+	})
+
+	t.Run("error stops delegation", func(t *testing.T) {
+		failing := &statusMapper{mockMapper: mockMapper{id: "failing"}, err: assert.AnError}
+		next := &statusMapper{mockMapper: mockMapper{id: "next"}, status: api.ComplianceEnrichmentStatusSuccess}
+
+		_, err := NewCompositeMapper(failing, next).Map(evidence, make(Scope))
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, 0, next.calls)
+	})
+
+	t.Run("result reports the producing mapper", func(t *testing.T) {
+		first := &statusMapper{mockMapper: mockMapper{id: "first"}, status: api.ComplianceEnrichmentStatusUnmapped}
+		second := &statusMapper{mockMapper: mockMapper{id: "second"}, status: api.ComplianceEnrichmentStatusSuccess}
+
+		_, id, err := NewCompositeMapper(first, second).MapWithID(evidence, make(Scope))
+		require.NoError(t, err)
+		assert.Equal(t, ID("second"), id)
+
+		failing := &statusMapper{mockMapper: mockMapper{id: "failing"}, err: assert.AnError}
+		_, id, err = NewCompositeMapper(failing, second).MapWithID(evidence, make(Scope))
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, ID("failing"), id)
+	})
+
+	t.Run("no mappers leaves evidence unmapped", func(t *testing.T) {
+		compliance, id, err := NewCompositeMapper().MapWithID(evidence, make(Scope))
+		require.NoError(t, err)
+		assert.Equal(t, CompositeID, id)
+		assert.Equal(t, api.ComplianceEnrichmentStatusUnmapped, compliance.EnrichmentStatus)
+		assert.Equal(t, api.ComplianceStatusUnknown, compliance.Status)
+	})
+
+	t.Run("evaluation plans fan out", func(t *testing.T) {
+		first := &mockMapper{id: "first"}
+		second := &mockMapper{id: "second"}
+		plans := []layer4.AssessmentPlan{
+			{Control: layer4.Mapping{ReferenceId: "AC-1"}},
+		}
+
+		NewCompositeMapper(first, second).AddEvaluationPlan("test-catalog", plans...)
+		assert.Equal(t, plans, first.plans["test-catalog"])
+		assert.Equal(t, plans, second.plans["test-catalog"])
+	})
+}
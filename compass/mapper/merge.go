@@ -0,0 +1,115 @@
+package mapper
+
+import (
+	"slices"
+
+	"github.com/complytime/complybeacon/compass/api"
+)
+
+// statusSeverity ranks compliance statuses from least to most severe:
+//
+//	Non-Compliant > Unknown > Needs Review > Compliant > Exempt > Not Applicable
+//
+// A result that could not be evaluated ranks above one awaiting review, since
+// nothing is known about it. Statuses without an entry rank below all others.
+var statusSeverity = map[api.ComplianceStatus]int{
+	api.ComplianceStatusNotApplicable: 1,
+	api.ComplianceStatusExempt:        2,
+	api.ComplianceStatusCompliant:     3,
+	api.ComplianceStatusNeedsReview:   4,
+	api.ComplianceStatusUnknown:       5,
+	api.ComplianceStatusNonCompliant:  6,
+}
+
+// MoreSevere reports whether status a is more severe than status b.
+func MoreSevere(a, b api.ComplianceStatus) bool {
+	return statusSeverity[a] > statusSeverity[b]
+}
+
+// MergeCompliance combines the results of two mappers for the same evidence.
+//
+// An unmapped result carries no compliance context, so when only one result
+// is mapped it is returned as is; when both are unmapped, a is returned.
+// Otherwise the merged result:
+//   - takes the most severe status, as ranked by MoreSevere, preferring a on
+//     a tie;
+//   - takes the control, enrichment status, and remediation action of the
+//     result whose status won, falling back to the other's remediation action;
+//   - lists the controls of both results in Controls, without duplicates;
+//   - lists the frameworks and requirements of both results, without
+//     duplicates and in order of first appearance, a first;
+//   - keeps the risk with the higher score.
+func MergeCompliance(a, b api.Compliance) api.Compliance {
+	aUnmapped := a.EnrichmentStatus == api.ComplianceEnrichmentStatusUnmapped
+	bUnmapped := b.EnrichmentStatus == api.ComplianceEnrichmentStatusUnmapped
+	switch {
+	case aUnmapped && !bUnmapped:
+		return b
+	case bUnmapped:
+		return a
+	}
+
+	winner, other := a, b
+	if MoreSevere(b.Status, a.Status) {
+		winner, other = b, a
+	}
+
+	merged := winner
+	if merged.RemediationAction == nil {
+		merged.RemediationAction = other.RemediationAction
+	}
+
+	controls := []api.ComplianceControl{}
+	for _, control := range slices.Concat(complianceControls(a), complianceControls(b)) {
+		if !slices.ContainsFunc(controls, func(c api.ComplianceControl) bool {
+			return c.CatalogId == control.CatalogId && c.Id == control.Id
+		}) {
+			controls = append(controls, control)
+		}
+	}
+	merged.Controls = &controls
+
+	merged.Frameworks = api.ComplianceFrameworks{
+		Frameworks:   unique(a.Frameworks.Frameworks, b.Frameworks.Frameworks),
+		Requirements: unique(a.Frameworks.Requirements, b.Frameworks.Requirements),
+	}
+	merged.Risk = higherRisk(a.Risk, b.Risk)
+	return merged
+}
+
+// complianceControls returns every control a result was mapped to.
+func complianceControls(compliance api.Compliance) []api.ComplianceControl {
+	if compliance.Controls != nil && len(*compliance.Controls) > 0 {
+		return *compliance.Controls
+	}
+	return []api.ComplianceControl{compliance.Control}
+}
+
+// unique returns the values of lists in order of first appearance, without
+// duplicates.
+func unique(lists ...[]string) []string {
+	values := []string{}
+	for _, list := range lists {
+		for _, value := range list {
+			if !slices.Contains(values, value) {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
+
+// higherRisk returns the risk with the higher score. A risk without a score
+// is kept only when the other risk is nil.
+func higherRisk(a, b *api.ComplianceRisk) *api.ComplianceRisk {
+	switch {
+	case b == nil || (b.Score == nil && a != nil):
+		return a
+	case a == nil || a.Score == nil:
+		return b
+	case *b.Score > *a.Score:
+		return b
+	default:
+		return a
+	}
+}
@@ -0,0 +1,152 @@
+package mapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/complytime/complybeacon/compass/api"
+)
+
+func TestMergeCompliance(t *testing.T) {
+	ac1 := api.ComplianceControl{CatalogId: "nist", Id: "AC-1", Category: "Access Control"}
+	ac2 := api.ComplianceControl{CatalogId: "osps", Id: "AC-2", Category: "Access Control"}
+	block := api.ComplianceRemediationActionBlock
+	low, high := 20.0, 80.0
+
+	compliance := func(control api.ComplianceControl, status api.ComplianceStatus, frameworks, requirements []string) api.Compliance {
+		return api.Compliance{
+			Control:          control,
+			Status:           status,
+			EnrichmentStatus: api.ComplianceEnrichmentStatusSuccess,
+			Frameworks: api.ComplianceFrameworks{
+				Frameworks:   frameworks,
+				Requirements: requirements,
+			},
+		}
+	}
+
+	t.Run("frameworks and requirements are unioned without duplicates", func(t *testing.T) {
+		a := compliance(ac1, api.ComplianceStatusCompliant, []string{"NIST-800-53", "ISO-27001"}, []string{"AC-1", "A.9.1.1"})
+		b := compliance(ac2, api.ComplianceStatusCompliant, []string{"ISO-27001", "SOC2"}, []string{"A.9.1.1", "CC6.1"})
+
+		merged := MergeCompliance(a, b)
+
+		assert.Equal(t, []string{"NIST-800-53", "ISO-27001", "SOC2"}, merged.Frameworks.Frameworks)
+		assert.Equal(t, []string{"AC-1", "A.9.1.1", "CC6.1"}, merged.Frameworks.Requirements)
+		assert.Equal(t, []string{"NIST-800-53", "ISO-27001"}, a.Frameworks.Frameworks, "inputs should not be modified")
+		if assert.NotNil(t, merged.Controls) {
+			assert.Equal(t, []api.ComplianceControl{ac1, ac2}, *merged.Controls)
+		}
+	})
+
+	t.Run("controls are deduplicated", func(t *testing.T) {
+		a := compliance(ac1, api.ComplianceStatusCompliant, nil, nil)
+		a.Controls = &[]api.ComplianceControl{ac1, ac2}
+		b := compliance(ac2, api.ComplianceStatusCompliant, nil, nil)
+
+		merged := MergeCompliance(a, b)
+
+		if assert.NotNil(t, merged.Controls) {
+			assert.Equal(t, []api.ComplianceControl{ac1, ac2}, *merged.Controls)
+		}
+		assert.Equal(t, []string{}, merged.Frameworks.Frameworks)
+	})
+
+	statusTests := []struct {
+		name            string
+		a, b            api.ComplianceStatus
+		expectedStatus  api.ComplianceStatus
+		expectedControl api.ComplianceControl
+	}{
+		{
+			name:            "non-compliant wins over compliant",
+			a:               api.ComplianceStatusCompliant,
+			b:               api.ComplianceStatusNonCompliant,
+			expectedStatus:  api.ComplianceStatusNonCompliant,
+			expectedControl: ac2,
+		},
+		{
+			name:            "unknown wins over needs review",
+			a:               api.ComplianceStatusNeedsReview,
+			b:               api.ComplianceStatusUnknown,
+			expectedStatus:  api.ComplianceStatusUnknown,
+			expectedControl: ac2,
+		},
+		{
+			name:            "needs review wins over compliant",
+			a:               api.ComplianceStatusCompliant,
+			b:               api.ComplianceStatusNeedsReview,
+			expectedStatus:  api.ComplianceStatusNeedsReview,
+			expectedControl: ac2,
+		},
+		{
+			name:            "compliant wins over not applicable",
+			a:               api.ComplianceStatusNotApplicable,
+			b:               api.ComplianceStatusCompliant,
+			expectedStatus:  api.ComplianceStatusCompliant,
+			expectedControl: ac2,
+		},
+		{
+			name:            "ties keep the first result",
+			a:               api.ComplianceStatusNonCompliant,
+			b:               api.ComplianceStatusNonCompliant,
+			expectedStatus:  api.ComplianceStatusNonCompliant,
+			expectedControl: ac1,
+		},
+	}
+	for _, tt := range statusTests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged := MergeCompliance(
+				compliance(ac1, tt.a, nil, nil),
+				compliance(ac2, tt.b, nil, nil),
+			)
+
+			assert.Equal(t, tt.expectedStatus, merged.Status)
+			assert.Equal(t, tt.expectedControl, merged.Control)
+		})
+	}
+
+	t.Run("remediation action and risk", func(t *testing.T) {
+		a := compliance(ac1, api.ComplianceStatusNonCompliant, nil, nil)
+		a.Risk = &api.ComplianceRisk{Score: &low}
+		b := compliance(ac2, api.ComplianceStatusCompliant, nil, nil)
+		b.RemediationAction = &block
+		b.Risk = &api.ComplianceRisk{Score: &high}
+
+		merged := MergeCompliance(a, b)
+
+		assert.Equal(t, ac1, merged.Control)
+		assert.Equal(t, &block, merged.RemediationAction)
+		assert.Equal(t, &high, merged.Risk.Score)
+	})
+
+	t.Run("unmapped results are ignored", func(t *testing.T) {
+		mapped := compliance(ac1, api.ComplianceStatusCompliant, []string{"NIST-800-53"}, nil)
+		unmapped := compliance(api.ComplianceControl{Id: "UNMAPPED"}, api.ComplianceStatusUnknown, nil, nil)
+		unmapped.EnrichmentStatus = api.ComplianceEnrichmentStatusUnmapped
+
+		assert.Equal(t, mapped, MergeCompliance(unmapped, mapped))
+		assert.Equal(t, mapped, MergeCompliance(mapped, unmapped))
+		assert.Equal(t, unmapped, MergeCompliance(unmapped, unmapped))
+	})
+}
+
+func TestMoreSevere(t *testing.T) {
+	// Statuses from most to least severe.
+	order := []api.ComplianceStatus{
+		api.ComplianceStatusNonCompliant,
+		api.ComplianceStatusUnknown,
+		api.ComplianceStatusNeedsReview,
+		api.ComplianceStatusCompliant,
+		api.ComplianceStatusExempt,
+		api.ComplianceStatusNotApplicable,
+		api.ComplianceStatus("Unrecognized"),
+	}
+
+	for i, a := range order {
+		for j, b := range order {
+			assert.Equal(t, i < j, MoreSevere(a, b), "MoreSevere(%q, %q)", a, b)
+		}
+	}
+}
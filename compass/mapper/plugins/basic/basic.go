@@ -1,7 +1,13 @@
 package basic
 
 import (
+	"errors"
+	"fmt"
 	"log"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
 
 	"github.com/ossf/gemara/layer2"
 	"github.com/ossf/gemara/layer4"
@@ -21,6 +27,10 @@ type ProcedureInfo struct {
 type ControlData struct {
 	Mappings []layer2.Mapping
 	Category string
+	Title    string
+	// Recommendations holds the remediation recommendation of each
+	// assessment requirement, keyed by requirement ID.
+	Recommendations map[string]string
 }
 
 // A basic mapper processes assessment plans and maps evidence to compliance controls,
@@ -32,10 +42,137 @@ var (
 )
 
 type Mapper struct {
-	plans map[string][]layer4.AssessmentPlan
+	// mu guards plans, which may be added to while evidence is mapped.
+	mu       sync.RWMutex
+	plans    map[string][]layer4.AssessmentPlan
+	statuses map[api.EvidencePolicyEvaluationStatus]api.ComplianceStatus
+	// aggregate reports every matching control instead of only the first.
+	aggregate bool
+	// unmappedCatalog and unmappedCategory are reported for rules that do
+	// not map to any control.
+	unmappedCatalog  string
+	unmappedCategory string
+	// actions holds the remediation action prescribed for each control ID.
+	actions map[string]api.ComplianceRemediationAction
+	// catalogParameters holds the parameter defaults recommended by each
+	// catalog, keyed by catalog ID, control ID, and then parameter ID.
+	catalogParameters map[string]map[string]map[string]string
+	// parameters holds the configured parameter values of each control ID,
+	// keyed by parameter ID. They override the catalog defaults.
+	parameters map[string]map[string]string
+	// scoring configures risk scoring; results are not scored when nil.
+	scoring *RiskScoring
+	// checkThreshold is the fraction of checks that must pass for evidence
+	// with per-check counts to be compliant.
+	checkThreshold float64
+	// controlThresholds override checkThreshold for individual control IDs.
+	controlThresholds map[string]float64
+}
+
+// Option configures optional behavior of the basic Mapper.
+type Option func(*Mapper)
+
+// WithStatusMapping overrides how policy evaluation statuses are mapped to
+// compliance statuses. Entries are merged over the defaults, so only the
+// statuses that need to change have to be provided.
+func WithStatusMapping(mapping map[api.EvidencePolicyEvaluationStatus]api.ComplianceStatus) Option {
+	return func(m *Mapper) {
+		for status, complianceStatus := range mapping {
+			m.statuses[status] = complianceStatus
+		}
+	}
+}
+
+// WithAggregation makes Map report every control matched by the policy rule
+// across all catalogs. Each match gets its own status, so with per-control
+// check thresholds the same evidence can satisfy one control and fail
+// another. The match with the most severe status, as ranked by
+// mapper.MoreSevere, is returned as the primary control, with the first match
+// winning a tie. All matches are listed in Controls, and the framework
+// requirements and standards of every match are merged.
+func WithAggregation() Option {
+	return func(m *Mapper) {
+		m.aggregate = true
+	}
+}
+
+// WithUnmappedDefaults sets the catalog ID and category reported for policy
+// rules that do not map to any control, so unmapped results can be routed to
+// a designated catalog instead of the built-in placeholders.
+func WithUnmappedDefaults(catalogId, category string) Option {
+	return func(m *Mapper) {
+		m.unmappedCatalog = catalogId
+		m.unmappedCategory = category
+	}
+}
+
+// WithRemediationActions sets the remediation action recommended for
+// evidence mapped to each control, keyed by catalog control ID.
+func WithRemediationActions(actions map[string]api.ComplianceRemediationAction) Option {
+	return func(m *Mapper) {
+		for controlId, action := range actions {
+			m.actions[controlId] = action
+		}
+	}
+}
+
+// WithCatalogParameters sets the parameter defaults recommended by the
+// catalog with ID catalogId, keyed by control ID and then by parameter ID.
+// They are reported for evidence mapped to the control in that catalog.
+func WithCatalogParameters(catalogId string, parameters map[string]map[string]string) Option {
+	return func(m *Mapper) {
+		m.addCatalogParameters(catalogId, parameters)
+	}
+}
+
+// WithControlParameters sets the parameter values reported for evidence
+// mapped to each control, keyed by catalog control ID and then by parameter
+// ID. They override the defaults recommended by the catalog.
+func WithControlParameters(parameters map[string]map[string]string) Option {
+	return func(m *Mapper) {
+		for controlId, values := range parameters {
+			m.addControlParameters(controlId, values)
+		}
+	}
+}
+
+// WithCheckThreshold sets the fraction of checks, from 0 to 1, that must pass
+// for evidence reporting per-check counts to be compliant. The default of 1
+// makes any failed check non-compliant; a threshold of 0.8 accepts evidence
+// with 8 of 10 checks passed. Evidence where every check failed is always
+// non-compliant.
+func WithCheckThreshold(threshold float64) Option {
+	return func(m *Mapper) {
+		m.checkThreshold = clampWeight(threshold)
+	}
+}
+
+// WithControlCheckThresholds overrides the check threshold for evidence
+// mapped to individual controls, keyed by catalog control ID, so a control
+// can demand more or fewer passing checks than the default.
+func WithControlCheckThresholds(thresholds map[string]float64) Option {
+	return func(m *Mapper) {
+		for controlId, threshold := range thresholds {
+			m.controlThresholds[controlId] = clampWeight(threshold)
+		}
+	}
+}
+
+// defaultStatusMapping returns the built-in evaluation to compliance status table.
+func defaultStatusMapping() map[api.EvidencePolicyEvaluationStatus]api.ComplianceStatus {
+	return map[api.EvidencePolicyEvaluationStatus]api.ComplianceStatus{
+		api.EvidencePolicyEvaluationStatusPassed:        api.ComplianceStatusCompliant,
+		api.EvidencePolicyEvaluationStatusFailed:        api.ComplianceStatusNonCompliant,
+		api.EvidencePolicyEvaluationStatusNotRun:        api.ComplianceStatusNotApplicable,
+		api.EvidencePolicyEvaluationStatusNotApplicable: api.ComplianceStatusNotApplicable,
+		api.EvidencePolicyEvaluationStatusNeedsReview:   api.ComplianceStatusNeedsReview,
+	}
 }
 
 func (m *Mapper) AddEvaluationPlan(catalogId string, plans ...layer4.AssessmentPlan) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	existingPlans, ok := m.plans[catalogId]
 	if !ok {
 		m.plans[catalogId] = plans
@@ -45,25 +182,111 @@ func (m *Mapper) AddEvaluationPlan(catalogId string, plans ...layer4.AssessmentP
 	}
 }
 
-func NewBasicMapper() *Mapper {
-	return &Mapper{
-		plans: make(map[string][]layer4.AssessmentPlan),
+// AddControlParameters sets parameter values reported for evidence mapped to
+// controlId, keyed by parameter ID. Values are merged over those already set
+// for the control.
+func (m *Mapper) AddControlParameters(controlId string, parameters map[string]string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.addControlParameters(controlId, parameters)
+}
+
+// AddCatalogParameters sets the parameter defaults recommended by the catalog
+// with ID catalogId, keyed by control ID and then by parameter ID.
+func (m *Mapper) AddCatalogParameters(catalogId string, parameters map[string]map[string]string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.addCatalogParameters(catalogId, parameters)
+}
+
+func (m *Mapper) addCatalogParameters(catalogId string, parameters map[string]map[string]string) {
+	for controlId, values := range parameters {
+		if len(values) == 0 {
+			continue
+		}
+		if m.catalogParameters[catalogId] == nil {
+			m.catalogParameters[catalogId] = make(map[string]map[string]string)
+		}
+		if m.catalogParameters[catalogId][controlId] == nil {
+			m.catalogParameters[catalogId][controlId] = make(map[string]string, len(values))
+		}
+		maps.Copy(m.catalogParameters[catalogId][controlId], values)
+	}
+}
+
+func (m *Mapper) addControlParameters(controlId string, parameters map[string]string) {
+	if len(parameters) == 0 {
+		return
+	}
+	if m.parameters[controlId] == nil {
+		m.parameters[controlId] = make(map[string]string, len(parameters))
 	}
+	maps.Copy(m.parameters[controlId], parameters)
+}
+
+// Validate checks the evaluation plans added so far. Procedure IDs must be
+// unique within a catalog, since a policy rule resolves to a single
+// procedure; otherwise later procedures silently replace earlier ones.
+func (m *Mapper) Validate() error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var errs []error
+	for _, catalogId := range slices.Sorted(maps.Keys(m.plans)) {
+		seen := make(map[string]bool)
+		var duplicates []string
+		for _, plan := range m.plans[catalogId] {
+			for _, assessment := range plan.Assessments {
+				for _, procedure := range assessment.Procedures {
+					if seen[procedure.Id] && !slices.Contains(duplicates, procedure.Id) {
+						duplicates = append(duplicates, procedure.Id)
+					}
+					seen[procedure.Id] = true
+				}
+			}
+		}
+		if len(duplicates) > 0 {
+			errs = append(errs, fmt.Errorf("catalog %s: duplicate procedure IDs: %s", catalogId, strings.Join(duplicates, ", ")))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func NewBasicMapper(opts ...Option) *Mapper {
+	m := &Mapper{
+		plans:             make(map[string][]layer4.AssessmentPlan),
+		statuses:          defaultStatusMapping(),
+		unmappedCatalog:   "UNMAPPED",
+		unmappedCategory:  "UNCATEGORIZED",
+		actions:           make(map[string]api.ComplianceRemediationAction),
+		catalogParameters: make(map[string]map[string]map[string]string),
+		parameters:        make(map[string]map[string]string),
+		checkThreshold:    1,
+		controlThresholds: make(map[string]float64),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
 func (m *Mapper) PluginName() mapper.ID {
 	return ID
 }
 
-func (m *Mapper) Map(evidence api.Evidence, scope mapper.Scope) api.Compliance {
-
-	// Map decision to status
-	status := m.mapDecision(evidence.PolicyEvaluationStatus)
+func (m *Mapper) Map(evidence api.Evidence, scope mapper.Scope) (api.Compliance, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 
 	var failureReasons []string
+	var matches []api.Compliance
 
-	// Process each catalog
-	for catalogId, plans := range m.plans {
+	// Process each catalog in sorted ID order so that when several catalogs
+	// define the same policy rule, the lexically first catalog always wins.
+	for _, catalogId := range slices.Sorted(maps.Keys(m.plans)) {
+		plans := m.plans[catalogId]
 		catalog, ok := scope[catalogId]
 		if !ok {
 			log.Printf("WARNING: Catalog %s not found in scope for policy %s", catalogId, evidence.PolicyRuleId)
@@ -82,12 +305,16 @@ func (m *Mapper) Map(evidence api.Evidence, scope mapper.Scope) api.Compliance {
 
 			// Look up control data
 			if ctrlData, ok := controlData[procedureInfo.ControlID]; ok {
+				status := m.mapResult(evidence, procedureInfo.ControlID)
 				compliance := api.Compliance{
 					Control: api.ComplianceControl{
 						Id:                     procedureInfo.RequirementID,
 						Category:               ctrlData.Category,
-						RemediationDescription: &procedureInfo.Documentation,
+						RemediationDescription: optionalString(ctrlData.Recommendations[procedureInfo.RequirementID]),
+						AssessmentProcedure:    optionalString(procedureInfo.Documentation),
 						CatalogId:              catalogId,
+						CatalogVersion:         catalogVersion(catalog),
+						Title:                  controlTitle(ctrlData.Title, evidence.PolicyRuleName),
 					},
 					Frameworks: api.ComplianceFrameworks{
 						Requirements: m.extractRequirements(ctrlData.Mappings),
@@ -96,8 +323,18 @@ func (m *Mapper) Map(evidence api.Evidence, scope mapper.Scope) api.Compliance {
 					Status:           status,
 					EnrichmentStatus: api.ComplianceEnrichmentStatusSuccess,
 				}
+				if action, ok := m.actions[procedureInfo.ControlID]; ok {
+					compliance.RemediationAction = &action
+				}
+				if parameters := m.controlParameters(catalogId, procedureInfo.ControlID); len(parameters) > 0 {
+					compliance.Control.Parameters = &parameters
+				}
+				m.applyRiskScore(&compliance, procedureInfo.ControlID)
 
-				return compliance
+				if !m.aggregate {
+					return compliance, nil
+				}
+				matches = append(matches, compliance)
 			} else {
 				log.Printf("WARNING: Control data not found for control ID %s in catalog %s for policy %s", procedureInfo.ControlID, catalogId, evidence.PolicyRuleId)
 				failureReasons = append(failureReasons, "control data not found")
@@ -108,6 +345,10 @@ func (m *Mapper) Map(evidence api.Evidence, scope mapper.Scope) api.Compliance {
 		}
 	}
 
+	if len(matches) > 0 {
+		return m.mergeMatches(matches), nil
+	}
+
 	// Log final failure if no mapping was found
 	if len(failureReasons) > 0 {
 		log.Printf("WARNING: Failed to map policy %s from engine %s. Reasons: %v", evidence.PolicyRuleId, evidence.PolicyEngineName, failureReasons)
@@ -117,29 +358,193 @@ func (m *Mapper) Map(evidence api.Evidence, scope mapper.Scope) api.Compliance {
 		Status: api.ComplianceStatusUnknown,
 		Control: api.ComplianceControl{
 			Id:        "UNMAPPED",
-			CatalogId: "UNMAPPED",
-			Category:  "UNCATEGORIZED",
+			CatalogId: m.unmappedCatalog,
+			Category:  m.unmappedCategory,
+			Title:     controlTitle("", evidence.PolicyRuleName),
 		},
 		EnrichmentStatus: api.ComplianceEnrichmentStatusUnmapped,
 		Frameworks: api.ComplianceFrameworks{
 			Frameworks:   []string{},
 			Requirements: []string{},
 		},
+	}, nil
+}
+
+// controlParameters returns the parameters reported for controlId in the
+// catalog with ID catalogId: the defaults recommended by the catalog,
+// overridden by the values configured for the control.
+func (m *Mapper) controlParameters(catalogId, controlId string) map[string]string {
+	parameters := make(map[string]string)
+	maps.Copy(parameters, m.catalogParameters[catalogId][controlId])
+	maps.Copy(parameters, m.parameters[controlId])
+	return parameters
+}
+
+// catalogVersion returns the catalog's version, or nil when the catalog does
+// not declare one.
+func catalogVersion(catalog layer2.Catalog) *string {
+	if catalog.Metadata.Version == "" {
+		return nil
 	}
+	version := catalog.Metadata.Version
+	return &version
 }
 
-// mapDecision maps a decision string to status and status ID.
-func (m *Mapper) mapDecision(status api.EvidencePolicyEvaluationStatus) api.ComplianceStatus {
-	switch status {
-	case api.Passed:
-		return api.ComplianceStatusCompliant
-	case api.Failed:
+// optionalString returns a pointer to s, or nil when s is empty.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// controlTitle returns the catalog title of the control, falling back to the
+// policy rule name reported with the evidence when the catalog has none.
+func controlTitle(catalogTitle string, policyRuleName *string) *string {
+	if catalogTitle != "" {
+		return &catalogTitle
+	}
+	if policyRuleName != nil && *policyRuleName != "" {
+		return policyRuleName
+	}
+	return nil
+}
+
+// mergeMatches combines the compliance results of several matching controls.
+// The worst status wins: the most severe match, or the first of equally severe
+// ones, is the primary control. Requirements and standards are merged without
+// duplicates.
+func (m *Mapper) mergeMatches(matches []api.Compliance) api.Compliance {
+	primary := 0
+	for i, match := range matches[1:] {
+		if mapper.MoreSevere(match.Status, matches[primary].Status) {
+			primary = i + 1
+		}
+	}
+	merged := matches[primary]
+	// The primary control is listed first, followed by the other matches in
+	// catalog order.
+	controls := []api.ComplianceControl{merged.Control}
+	requirements, standards := []string{}, []string{}
+	for i, match := range matches {
+		if i != primary {
+			controls = append(controls, match.Control)
+		}
+		requirements = appendUnique(requirements, match.Frameworks.Requirements...)
+		standards = appendUnique(standards, match.Frameworks.Frameworks...)
+	}
+	merged.Controls = &controls
+	merged.Risk = highestRisk(matches)
+	merged.Frameworks = api.ComplianceFrameworks{
+		Requirements: requirements,
+		Frameworks:   standards,
+	}
+	return merged
+}
+
+// applyRiskScore sets the risk score of a compliance result mapped to
+// controlId when risk scoring is enabled.
+func (m *Mapper) applyRiskScore(compliance *api.Compliance, controlId string) {
+	if m.scoring == nil {
+		return
+	}
+	score, ok := m.scoring.score(compliance.Status, controlId, compliance.Frameworks.Frameworks)
+	if !ok {
+		return
+	}
+	compliance.Risk = &api.ComplianceRisk{Score: &score}
+}
+
+// highestRisk returns the risk of the match with the highest score.
+func highestRisk(matches []api.Compliance) *api.ComplianceRisk {
+	var highest *api.ComplianceRisk
+	for _, match := range matches {
+		if match.Risk == nil || match.Risk.Score == nil {
+			continue
+		}
+		if highest == nil || *match.Risk.Score > *highest.Score {
+			highest = match.Risk
+		}
+	}
+	return highest
+}
+
+// appendUnique appends the values not already present in list.
+func appendUnique(list []string, values ...string) []string {
+	for _, value := range values {
+		if !slices.Contains(list, value) {
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
+// mapResult derives the compliance status of evidence mapped to controlId.
+// Evidence carrying per-check counts is compliant when the fraction of passed
+// checks meets the control's check threshold; any other evidence is mapped by
+// its evaluation status.
+func (m *Mapper) mapResult(evidence api.Evidence, controlId string) api.ComplianceStatus {
+	var passed, failed int
+	if evidence.ChecksPassed != nil {
+		passed = max(*evidence.ChecksPassed, 0)
+	}
+	if evidence.ChecksFailed != nil {
+		failed = max(*evidence.ChecksFailed, 0)
+	}
+	if passed+failed == 0 {
+		return m.mapDecision(evidence.PolicyEvaluationStatus)
+	}
+
+	threshold, ok := m.controlThresholds[controlId]
+	if !ok {
+		threshold = m.checkThreshold
+	}
+	ratio := float64(passed) / float64(passed+failed)
+	if passed == 0 || ratio < threshold {
 		return api.ComplianceStatusNonCompliant
-	case api.NotRun, api.NotApplicable:
-		return api.ComplianceStatusNotApplicable
-	default:
+	}
+	return api.ComplianceStatusCompliant
+}
+
+// mapDecision maps a decision string to status using the configured status
+// mapping. Statuses without an entry are reported as unknown.
+func (m *Mapper) mapDecision(status api.EvidencePolicyEvaluationStatus) api.ComplianceStatus {
+	complianceStatus, ok := m.statuses[status]
+	if !ok {
 		return api.ComplianceStatusUnknown
 	}
+	return complianceStatus
+}
+
+// Procedures returns the procedures loaded from evaluation plans, keyed by
+// catalog ID and then by procedure ID, as Map resolves policy rules. The
+// returned maps are copies and may be modified by the caller.
+func (m *Mapper) Procedures() map[string]map[string]ProcedureInfo {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	procedures := make(map[string]map[string]ProcedureInfo, len(m.plans))
+	for catalogId, plans := range m.plans {
+		procedures[catalogId] = m.buildProceduresMap(plans)
+	}
+	return procedures
+}
+
+// RulesForControl returns the policy rules that assess controlId in the
+// catalog, in sorted order. It is the inverse of the lookup done by Map, so a
+// control without rules has no implementing policy.
+func (m *Mapper) RulesForControl(catalogId, controlId string) []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	rules := []string{}
+	for procedureId, info := range m.buildProceduresMap(m.plans[catalogId]) {
+		if info.ControlID == controlId {
+			rules = append(rules, procedureId)
+		}
+	}
+	slices.Sort(rules)
+	return rules
 }
 
 // buildProceduresMap builds a map of procedure ID to procedure info.
@@ -167,9 +572,17 @@ func (m *Mapper) buildControlDataMap(catalog layer2.Catalog) map[string]ControlD
 
 	for _, family := range catalog.ControlFamilies {
 		for _, control := range family.Controls {
+			recommendations := make(map[string]string)
+			for _, requirement := range control.AssessmentRequirements {
+				if requirement.Recommendation != "" {
+					recommendations[requirement.Id] = strings.TrimSpace(requirement.Recommendation)
+				}
+			}
 			controlData[control.Id] = ControlData{
-				Mappings: control.GuidelineMappings,
-				Category: family.Title,
+				Mappings:        control.GuidelineMappings,
+				Category:        family.Title,
+				Title:           control.Title,
+				Recommendations: recommendations,
 			}
 		}
 	}
@@ -1,12 +1,18 @@
 package basic
 
 import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/ossf/gemara/layer2"
 	"github.com/ossf/gemara/layer4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/complytime/complybeacon/compass/api"
 	"github.com/complytime/complybeacon/compass/mapper"
@@ -29,27 +35,32 @@ func TestBasicMapper_MapWithPlans(t *testing.T) {
 	}{
 		{
 			name:           "compliance status is passed",
-			status:         api.Passed,
+			status:         api.EvidencePolicyEvaluationStatusPassed,
 			expectedStatus: api.ComplianceStatusCompliant,
 		},
 		{
 			name:           "compliance status is failed",
-			status:         api.Failed,
+			status:         api.EvidencePolicyEvaluationStatusFailed,
 			expectedStatus: api.ComplianceStatusNonCompliant,
 		},
 		{
 			name:           "compliance status is not run",
-			status:         api.NotRun,
+			status:         api.EvidencePolicyEvaluationStatusNotRun,
 			expectedStatus: api.ComplianceStatusNotApplicable,
 		},
 		{
 			name:           "compliance status is not applicable",
-			status:         api.NotApplicable,
+			status:         api.EvidencePolicyEvaluationStatusNotApplicable,
 			expectedStatus: api.ComplianceStatusNotApplicable,
 		},
+		{
+			name:           "compliance status needs review",
+			status:         api.EvidencePolicyEvaluationStatusNeedsReview,
+			expectedStatus: api.ComplianceStatusNeedsReview,
+		},
 		{
 			name:           "unmapped compliance status defaults to unknown",
-			status:         api.Unknown,
+			status:         api.EvidencePolicyEvaluationStatusUnknown,
 			expectedStatus: api.ComplianceStatusUnknown,
 		},
 	}
@@ -110,7 +121,8 @@ func TestBasicMapper_MapWithPlans(t *testing.T) {
 				"test-catalog": catalog,
 			}
 
-			compliance := basicMapper.Map(evidence, scope)
+			compliance, err := basicMapper.Map(evidence, scope)
+			require.NoError(t, err)
 
 			assert.NotNil(t, compliance)
 			assert.Equal(t, tt.expectedStatus, compliance.Status)
@@ -122,17 +134,693 @@ func TestBasicMapper_MapWithPlans(t *testing.T) {
 	}
 }
 
+func TestBasicMapper_MapCatalogVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+	}{
+		{
+			name:    "catalog version is reported",
+			version: "2025.02.25",
+		},
+		{
+			name: "unversioned catalog omits version",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			basicMapper := NewBasicMapper()
+			basicMapper.AddEvaluationPlan("test-catalog", layer4.AssessmentPlan{
+				Control: layer4.Mapping{EntryId: "AC-1", ReferenceId: "test-catalog"},
+				Assessments: []layer4.Assessment{
+					{
+						Requirement: layer4.Mapping{EntryId: "AC-1-REQ", ReferenceId: "test-catalog"},
+						Procedures:  []layer4.AssessmentProcedure{{Id: "AC-1"}},
+					},
+				},
+			})
+			scope := mapper.Scope{
+				"test-catalog": layer2.Catalog{
+					Metadata: layer2.Metadata{Id: "test-catalog", Version: tt.version},
+					ControlFamilies: []layer2.ControlFamily{
+						{Title: "Access Control", Controls: []layer2.Control{{Id: "AC-1"}}},
+					},
+				},
+			}
+			evidence := api.Evidence{
+				PolicyEngineName:       "test-policy-engine",
+				PolicyRuleId:           "AC-1",
+				PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
+				Timestamp:              time.Now(),
+			}
+
+			compliance, err := basicMapper.Map(evidence, scope)
+			require.NoError(t, err)
+
+			assert.Equal(t, api.ComplianceEnrichmentStatusSuccess, compliance.EnrichmentStatus)
+			if tt.version == "" {
+				assert.Nil(t, compliance.Control.CatalogVersion)
+				return
+			}
+			require.NotNil(t, compliance.Control.CatalogVersion)
+			assert.Equal(t, tt.version, *compliance.Control.CatalogVersion)
+		})
+	}
+}
+
+func TestBasicMapper_MapControlTitle(t *testing.T) {
+	ruleName := "Account review"
+	tests := []struct {
+		name          string
+		controlTitle  string
+		ruleName      *string
+		ruleId        string
+		expectedTitle *string
+	}{
+		{
+			name:          "catalog title is preferred",
+			controlTitle:  "Account Management",
+			ruleName:      &ruleName,
+			ruleId:        "AC-1",
+			expectedTitle: stringPtr("Account Management"),
+		},
+		{
+			name:          "rule name is used without a catalog title",
+			ruleName:      &ruleName,
+			ruleId:        "AC-1",
+			expectedTitle: &ruleName,
+		},
+		{
+			name:   "no title without either",
+			ruleId: "AC-1",
+		},
+		{
+			name:          "unmapped evidence reports the rule name",
+			ruleName:      &ruleName,
+			ruleId:        "unknown-rule",
+			expectedTitle: &ruleName,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			basicMapper := NewBasicMapper()
+			basicMapper.AddEvaluationPlan("test-catalog", layer4.AssessmentPlan{
+				Control: layer4.Mapping{EntryId: "AC-1", ReferenceId: "test-catalog"},
+				Assessments: []layer4.Assessment{
+					{
+						Requirement: layer4.Mapping{EntryId: "AC-1-REQ", ReferenceId: "test-catalog"},
+						Procedures:  []layer4.AssessmentProcedure{{Id: "AC-1"}},
+					},
+				},
+			})
+			scope := mapper.Scope{
+				"test-catalog": layer2.Catalog{
+					Metadata: layer2.Metadata{Id: "test-catalog"},
+					ControlFamilies: []layer2.ControlFamily{
+						{Title: "Access Control", Controls: []layer2.Control{{Id: "AC-1", Title: tt.controlTitle}}},
+					},
+				},
+			}
+			evidence := api.Evidence{
+				PolicyEngineName:       "test-policy-engine",
+				PolicyRuleId:           tt.ruleId,
+				PolicyRuleName:         tt.ruleName,
+				PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
+				Timestamp:              time.Now(),
+			}
+
+			compliance, err := basicMapper.Map(evidence, scope)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedTitle, compliance.Control.Title)
+		})
+	}
+}
+
+func TestBasicMapper_MapAssessmentProcedure(t *testing.T) {
+	basicMapper := NewBasicMapper()
+	for _, controlId := range []string{"AC-1", "AC-2"} {
+		basicMapper.AddEvaluationPlan("test-catalog", layer4.AssessmentPlan{
+			Control: layer4.Mapping{EntryId: controlId, ReferenceId: "test-catalog"},
+			Assessments: []layer4.Assessment{
+				{
+					Requirement: layer4.Mapping{EntryId: controlId + "-REQ", ReferenceId: "test-catalog"},
+					Procedures: []layer4.AssessmentProcedure{
+						{Id: controlId + "-PROC", Documentation: "Check that " + controlId + " is enforced"},
+					},
+				},
+			},
+		})
+	}
+	scope := mapper.Scope{
+		"test-catalog": layer2.Catalog{
+			Metadata: layer2.Metadata{Id: "test-catalog"},
+			ControlFamilies: []layer2.ControlFamily{
+				{
+					Title: "Access Control",
+					Controls: []layer2.Control{
+						{
+							Id: "AC-1",
+							AssessmentRequirements: []layer2.AssessmentRequirement{
+								{Id: "AC-1-REQ", Recommendation: "Enable AC-1 in the platform settings\n"},
+							},
+						},
+						{Id: "AC-2"},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name                string
+		policyRuleId        string
+		expectedRemediation *string
+		expectedProcedure   *string
+	}{
+		{
+			name:                "requirement with recommendation",
+			policyRuleId:        "AC-1-PROC",
+			expectedRemediation: stringPtr("Enable AC-1 in the platform settings"),
+			expectedProcedure:   stringPtr("Check that AC-1 is enforced"),
+		},
+		{
+			name:                "requirement without recommendation",
+			policyRuleId:        "AC-2-PROC",
+			expectedRemediation: nil,
+			expectedProcedure:   stringPtr("Check that AC-2 is enforced"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compliance, err := basicMapper.Map(api.Evidence{
+				PolicyEngineName:       "test-policy-engine",
+				PolicyRuleId:           tt.policyRuleId,
+				PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusFailed,
+				Timestamp:              time.Now(),
+			}, scope)
+			require.NoError(t, err)
+
+			assert.Equal(t, api.ComplianceEnrichmentStatusSuccess, compliance.EnrichmentStatus)
+			assert.Equal(t, tt.expectedRemediation, compliance.Control.RemediationDescription)
+			assert.Equal(t, tt.expectedProcedure, compliance.Control.AssessmentProcedure)
+		})
+	}
+}
+
+func TestBasicMapper_WithRemediationActions(t *testing.T) {
+	basicMapper := NewBasicMapper(WithRemediationActions(map[string]api.ComplianceRemediationAction{
+		"AC-1": api.ComplianceRemediationActionBlock,
+	}))
+	for _, controlId := range []string{"AC-1", "AC-2"} {
+		basicMapper.AddEvaluationPlan("test-catalog", layer4.AssessmentPlan{
+			Control: layer4.Mapping{EntryId: controlId, ReferenceId: "test-catalog"},
+			Assessments: []layer4.Assessment{
+				{
+					Requirement: layer4.Mapping{EntryId: controlId + "-REQ", ReferenceId: "test-catalog"},
+					Procedures:  []layer4.AssessmentProcedure{{Id: controlId + "-PROC"}},
+				},
+			},
+		})
+	}
+	scope := mapper.Scope{
+		"test-catalog": layer2.Catalog{
+			Metadata: layer2.Metadata{Id: "test-catalog"},
+			ControlFamilies: []layer2.ControlFamily{
+				{Title: "Access Control", Controls: []layer2.Control{{Id: "AC-1"}, {Id: "AC-2"}, {Id: "AC-3"}}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		policyRuleId   string
+		expectedAction *api.ComplianceRemediationAction
+	}{
+		{
+			name:         "control with prescribed action",
+			policyRuleId: "AC-1-PROC",
+			expectedAction: func() *api.ComplianceRemediationAction {
+				action := api.ComplianceRemediationActionBlock
+				return &action
+			}(),
+		},
+		{
+			name:           "control without prescribed action",
+			policyRuleId:   "AC-2-PROC",
+			expectedAction: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compliance, err := basicMapper.Map(api.Evidence{
+				PolicyEngineName:       "test-policy-engine",
+				PolicyRuleId:           tt.policyRuleId,
+				PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusFailed,
+				Timestamp:              time.Now(),
+			}, scope)
+			require.NoError(t, err)
+
+			assert.Equal(t, api.ComplianceEnrichmentStatusSuccess, compliance.EnrichmentStatus)
+			assert.Equal(t, tt.expectedAction, compliance.RemediationAction)
+		})
+	}
+}
+
+func TestBasicMapper_WithControlParameters(t *testing.T) {
+	basicMapper := NewBasicMapper(
+		WithCatalogParameters("test-catalog", map[string]map[string]string{
+			"AC-1": {"ac-1_prm_1": "monthly", "ac-1_prm_3": "enabled"},
+		}),
+		WithControlParameters(map[string]map[string]string{
+			"AC-1": {"ac-1_prm_1": "quarterly"},
+		}),
+	)
+	basicMapper.AddControlParameters("AC-1", map[string]string{"ac-1_prm_2": "12"})
+	basicMapper.AddCatalogParameters("test-catalog", map[string]map[string]string{"AC-2": {"ac-2_prm_1": "30"}})
+	basicMapper.AddCatalogParameters("other-catalog", map[string]map[string]string{"AC-3": {"ac-3_prm_1": "5"}})
+	for _, controlId := range []string{"AC-1", "AC-2", "AC-3"} {
+		basicMapper.AddEvaluationPlan("test-catalog", layer4.AssessmentPlan{
+			Control: layer4.Mapping{EntryId: controlId, ReferenceId: "test-catalog"},
+			Assessments: []layer4.Assessment{
+				{
+					Requirement: layer4.Mapping{EntryId: controlId + "-REQ", ReferenceId: "test-catalog"},
+					Procedures:  []layer4.AssessmentProcedure{{Id: controlId + "-PROC"}},
+				},
+			},
+		})
+	}
+	scope := mapper.Scope{
+		"test-catalog": layer2.Catalog{
+			Metadata: layer2.Metadata{Id: "test-catalog"},
+			ControlFamilies: []layer2.ControlFamily{
+				{Title: "Access Control", Controls: []layer2.Control{{Id: "AC-1"}, {Id: "AC-2"}}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		policyRuleId       string
+		expectedParameters *map[string]string
+	}{
+		{
+			name:               "configured parameters override catalog defaults",
+			policyRuleId:       "AC-1-PROC",
+			expectedParameters: &map[string]string{"ac-1_prm_1": "quarterly", "ac-1_prm_2": "12", "ac-1_prm_3": "enabled"},
+		},
+		{
+			name:               "catalog defaults only",
+			policyRuleId:       "AC-2-PROC",
+			expectedParameters: &map[string]string{"ac-2_prm_1": "30"},
+		},
+		{
+			name:               "defaults of another catalog are not reported",
+			policyRuleId:       "AC-3-PROC",
+			expectedParameters: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compliance, err := basicMapper.Map(api.Evidence{
+				PolicyEngineName:       "test-policy-engine",
+				PolicyRuleId:           tt.policyRuleId,
+				PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusFailed,
+				Timestamp:              time.Now(),
+			}, scope)
+			require.NoError(t, err)
+
+			assert.Equal(t, api.ComplianceEnrichmentStatusSuccess, compliance.EnrichmentStatus)
+			assert.Equal(t, tt.expectedParameters, compliance.Control.Parameters)
+		})
+	}
+}
+
+func TestBasicMapper_WithStatusMapping(t *testing.T) {
+	basicMapper := NewBasicMapper(WithStatusMapping(map[api.EvidencePolicyEvaluationStatus]api.ComplianceStatus{
+		api.EvidencePolicyEvaluationStatusNotRun: api.ComplianceStatusNonCompliant,
+	}))
+
+	tests := []struct {
+		name           string
+		status         api.EvidencePolicyEvaluationStatus
+		expectedStatus api.ComplianceStatus
+	}{
+		{
+			name:           "overridden status uses custom mapping",
+			status:         api.EvidencePolicyEvaluationStatusNotRun,
+			expectedStatus: api.ComplianceStatusNonCompliant,
+		},
+		{
+			name:           "other statuses keep defaults",
+			status:         api.EvidencePolicyEvaluationStatusNotApplicable,
+			expectedStatus: api.ComplianceStatusNotApplicable,
+		},
+		{
+			name:           "passed keeps default",
+			status:         api.EvidencePolicyEvaluationStatusPassed,
+			expectedStatus: api.ComplianceStatusCompliant,
+		},
+		{
+			name:           "unmapped status defaults to unknown",
+			status:         api.EvidencePolicyEvaluationStatusUnknown,
+			expectedStatus: api.ComplianceStatusUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedStatus, basicMapper.mapDecision(tt.status))
+		})
+	}
+
+	t.Run("defaults are not shared between mappers", func(t *testing.T) {
+		assert.Equal(t, api.ComplianceStatusNotApplicable, NewBasicMapper().mapDecision(api.EvidencePolicyEvaluationStatusNotRun))
+	})
+}
+
+func TestBasicMapper_MapResultCheckCounts(t *testing.T) {
+	checks := func(n int) *int { return &n }
+
+	tests := []struct {
+		name           string
+		opts           []Option
+		status         api.EvidencePolicyEvaluationStatus
+		passed, failed *int
+		expectedStatus api.ComplianceStatus
+	}{
+		{
+			name:           "all checks passed",
+			status:         api.EvidencePolicyEvaluationStatusFailed,
+			passed:         checks(10),
+			failed:         checks(0),
+			expectedStatus: api.ComplianceStatusCompliant,
+		},
+		{
+			name:           "partial pass is non-compliant by default",
+			status:         api.EvidencePolicyEvaluationStatusPassed,
+			passed:         checks(8),
+			failed:         checks(2),
+			expectedStatus: api.ComplianceStatusNonCompliant,
+		},
+		{
+			name:           "partial pass meeting the threshold is compliant",
+			opts:           []Option{WithCheckThreshold(0.8)},
+			status:         api.EvidencePolicyEvaluationStatusFailed,
+			passed:         checks(8),
+			failed:         checks(2),
+			expectedStatus: api.ComplianceStatusCompliant,
+		},
+		{
+			name:           "partial pass below the threshold is non-compliant",
+			opts:           []Option{WithCheckThreshold(0.8)},
+			status:         api.EvidencePolicyEvaluationStatusPassed,
+			passed:         checks(7),
+			failed:         checks(3),
+			expectedStatus: api.ComplianceStatusNonCompliant,
+		},
+		{
+			name:           "all checks failed",
+			opts:           []Option{WithCheckThreshold(0)},
+			status:         api.EvidencePolicyEvaluationStatusPassed,
+			passed:         checks(0),
+			failed:         checks(10),
+			expectedStatus: api.ComplianceStatusNonCompliant,
+		},
+		{
+			name:           "only failed count reported",
+			status:         api.EvidencePolicyEvaluationStatusPassed,
+			failed:         checks(1),
+			expectedStatus: api.ComplianceStatusNonCompliant,
+		},
+		{
+			name:           "no counts uses evaluation status",
+			status:         api.EvidencePolicyEvaluationStatusNeedsReview,
+			expectedStatus: api.ComplianceStatusNeedsReview,
+		},
+		{
+			name:           "zero counts use evaluation status",
+			status:         api.EvidencePolicyEvaluationStatusNotRun,
+			passed:         checks(0),
+			failed:         checks(0),
+			expectedStatus: api.ComplianceStatusNotApplicable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			basicMapper := NewBasicMapper(tt.opts...)
+			status := basicMapper.mapResult(api.Evidence{
+				PolicyEngineName:       "test-policy-engine",
+				PolicyRuleId:           "AC-1",
+				PolicyEvaluationStatus: tt.status,
+				ChecksPassed:           tt.passed,
+				ChecksFailed:           tt.failed,
+				Timestamp:              time.Now(),
+			}, "AC-1")
+			assert.Equal(t, tt.expectedStatus, status)
+		})
+	}
+
+	t.Run("threshold is clamped", func(t *testing.T) {
+		assert.Equal(t, 1.0, NewBasicMapper(WithCheckThreshold(1.5)).checkThreshold)
+		assert.Equal(t, 0.0, NewBasicMapper(WithCheckThreshold(-1)).checkThreshold)
+		assert.Equal(t, 1.0, NewBasicMapper(WithControlCheckThresholds(map[string]float64{"AC-1": 2})).controlThresholds["AC-1"])
+	})
+}
+
+func TestBasicMapper_MapDeterministicCatalogOrder(t *testing.T) {
+	newPlan := func(catalogId string) layer4.AssessmentPlan {
+		return layer4.AssessmentPlan{
+			Control: layer4.Mapping{EntryId: "AC-1", ReferenceId: catalogId},
+			Assessments: []layer4.Assessment{
+				{
+					Requirement: layer4.Mapping{EntryId: catalogId + "-REQ", ReferenceId: catalogId},
+					Procedures:  []layer4.AssessmentProcedure{{Id: "AC-1"}},
+				},
+			},
+		}
+	}
+	newCatalog := func(catalogId string) layer2.Catalog {
+		return layer2.Catalog{
+			Metadata: layer2.Metadata{Id: catalogId},
+			ControlFamilies: []layer2.ControlFamily{
+				{Title: "Access Control", Controls: []layer2.Control{{Id: "AC-1"}}},
+			},
+		}
+	}
+
+	evidence := api.Evidence{
+		PolicyEngineName:       "test-policy-engine",
+		PolicyRuleId:           "AC-1",
+		PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
+		Timestamp:              time.Now(),
+	}
+	scope := mapper.Scope{
+		"catalog-a": newCatalog("catalog-a"),
+		"catalog-b": newCatalog("catalog-b"),
+	}
+
+	// Map iterates plans repeatedly to catch randomized map ordering.
+	for i := 0; i < 20; i++ {
+		basicMapper := NewBasicMapper()
+		basicMapper.AddEvaluationPlan("catalog-b", newPlan("catalog-b"))
+		basicMapper.AddEvaluationPlan("catalog-a", newPlan("catalog-a"))
+
+		compliance, err := basicMapper.Map(evidence, scope)
+		require.NoError(t, err)
+		assert.Equal(t, "catalog-a", compliance.Control.CatalogId)
+		assert.Equal(t, "catalog-a-REQ", compliance.Control.Id)
+	}
+}
+
+func TestBasicMapper_MapAggregation(t *testing.T) {
+	newPlan := func(catalogId, requirementId string) layer4.AssessmentPlan {
+		return layer4.AssessmentPlan{
+			Control: layer4.Mapping{EntryId: "AC-1", ReferenceId: catalogId},
+			Assessments: []layer4.Assessment{
+				{
+					Requirement: layer4.Mapping{EntryId: requirementId, ReferenceId: catalogId},
+					Procedures:  []layer4.AssessmentProcedure{{Id: "AC-1"}},
+				},
+			},
+		}
+	}
+	newCatalog := func(catalogId, standard string, requirements ...string) layer2.Catalog {
+		var entries []layer2.MappingEntry
+		for _, requirement := range requirements {
+			entries = append(entries, layer2.MappingEntry{ReferenceId: requirement})
+		}
+		return layer2.Catalog{
+			Metadata: layer2.Metadata{Id: catalogId},
+			ControlFamilies: []layer2.ControlFamily{
+				{
+					Title: "Access Control",
+					Controls: []layer2.Control{
+						{
+							Id:                "AC-1",
+							GuidelineMappings: []layer2.Mapping{{ReferenceId: standard, Entries: entries}},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	evidence := api.Evidence{
+		PolicyEngineName:       "test-policy-engine",
+		PolicyRuleId:           "AC-1",
+		PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusFailed,
+		Timestamp:              time.Now(),
+	}
+	scope := mapper.Scope{
+		"iso-catalog":  newCatalog("iso-catalog", "ISO-27001", "A.9.1", "SHARED-1"),
+		"nist-catalog": newCatalog("nist-catalog", "NIST-800-53", "AC-1", "SHARED-1"),
+	}
+
+	t.Run("default reports first match only", func(t *testing.T) {
+		basicMapper := NewBasicMapper()
+		basicMapper.AddEvaluationPlan("nist-catalog", newPlan("nist-catalog", "NIST-REQ"))
+		basicMapper.AddEvaluationPlan("iso-catalog", newPlan("iso-catalog", "ISO-REQ"))
+
+		compliance, err := basicMapper.Map(evidence, scope)
+		require.NoError(t, err)
+
+		assert.Equal(t, "iso-catalog", compliance.Control.CatalogId)
+		assert.Nil(t, compliance.Controls)
+		assert.Equal(t, []string{"ISO-27001"}, compliance.Frameworks.Frameworks)
+	})
+
+	t.Run("aggregation reports all matches", func(t *testing.T) {
+		basicMapper := NewBasicMapper(WithAggregation())
+		basicMapper.AddEvaluationPlan("nist-catalog", newPlan("nist-catalog", "NIST-REQ"))
+		basicMapper.AddEvaluationPlan("iso-catalog", newPlan("iso-catalog", "ISO-REQ"))
+
+		compliance, err := basicMapper.Map(evidence, scope)
+		require.NoError(t, err)
+
+		assert.Equal(t, api.ComplianceEnrichmentStatusSuccess, compliance.EnrichmentStatus)
+		assert.Equal(t, api.ComplianceStatusNonCompliant, compliance.Status)
+		assert.Equal(t, "iso-catalog", compliance.Control.CatalogId)
+
+		require.NotNil(t, compliance.Controls)
+		controls := *compliance.Controls
+		require.Len(t, controls, 2)
+		assert.Equal(t, "ISO-REQ", controls[0].Id)
+		assert.Equal(t, "NIST-REQ", controls[1].Id)
+
+		assert.Equal(t, []string{"ISO-27001", "NIST-800-53"}, compliance.Frameworks.Frameworks)
+		assert.Equal(t, []string{"A.9.1", "SHARED-1", "AC-1"}, compliance.Frameworks.Requirements)
+	})
+
+	t.Run("aggregation with a single match", func(t *testing.T) {
+		basicMapper := NewBasicMapper(WithAggregation())
+		basicMapper.AddEvaluationPlan("nist-catalog", newPlan("nist-catalog", "NIST-REQ"))
+
+		compliance, err := basicMapper.Map(evidence, scope)
+		require.NoError(t, err)
+
+		require.NotNil(t, compliance.Controls)
+		assert.Len(t, *compliance.Controls, 1)
+		assert.Equal(t, "NIST-REQ", compliance.Control.Id)
+	})
+}
+
+func TestBasicMapper_MapAggregationWorstStatus(t *testing.T) {
+	newPlan := func(catalogId, controlId string) layer4.AssessmentPlan {
+		return layer4.AssessmentPlan{
+			Control: layer4.Mapping{EntryId: controlId, ReferenceId: catalogId},
+			Assessments: []layer4.Assessment{
+				{
+					Requirement: layer4.Mapping{EntryId: controlId + "-REQ", ReferenceId: catalogId},
+					Procedures:  []layer4.AssessmentProcedure{{Id: "check-mfa"}},
+				},
+			},
+		}
+	}
+	newCatalog := func(catalogId, controlId string) layer2.Catalog {
+		return layer2.Catalog{
+			Metadata: layer2.Metadata{Id: catalogId},
+			ControlFamilies: []layer2.ControlFamily{
+				{Title: "Access Control", Controls: []layer2.Control{{Id: controlId}}},
+			},
+		}
+	}
+	checks := func(n int) *int { return &n }
+
+	// The same evidence meets the relaxed threshold of the ISO control but
+	// fails the default threshold of the NIST control.
+	evidence := api.Evidence{
+		PolicyEngineName:       "test-policy-engine",
+		PolicyRuleId:           "check-mfa",
+		PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
+		ChecksPassed:           checks(9),
+		ChecksFailed:           checks(1),
+		Timestamp:              time.Now(),
+	}
+	scope := mapper.Scope{
+		"iso-catalog":  newCatalog("iso-catalog", "A.9.4"),
+		"nist-catalog": newCatalog("nist-catalog", "IA-2"),
+	}
+
+	tests := []struct {
+		name             string
+		thresholds       map[string]float64
+		expectedStatus   api.ComplianceStatus
+		expectedControls []string
+	}{
+		{
+			name:             "non-compliant control wins over the first match",
+			thresholds:       map[string]float64{"A.9.4": 0.8},
+			expectedStatus:   api.ComplianceStatusNonCompliant,
+			expectedControls: []string{"IA-2-REQ", "A.9.4-REQ"},
+		},
+		{
+			name:             "first match wins a tie",
+			thresholds:       map[string]float64{"A.9.4": 0.8, "IA-2": 0.9},
+			expectedStatus:   api.ComplianceStatusCompliant,
+			expectedControls: []string{"A.9.4-REQ", "IA-2-REQ"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			basicMapper := NewBasicMapper(WithAggregation(), WithControlCheckThresholds(tt.thresholds))
+			basicMapper.AddEvaluationPlan("iso-catalog", newPlan("iso-catalog", "A.9.4"))
+			basicMapper.AddEvaluationPlan("nist-catalog", newPlan("nist-catalog", "IA-2"))
+
+			compliance, err := basicMapper.Map(evidence, scope)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, compliance.Status)
+			assert.Equal(t, tt.expectedControls[0], compliance.Control.Id)
+
+			require.NotNil(t, compliance.Controls)
+			var controls []string
+			for _, control := range *compliance.Controls {
+				controls = append(controls, control.Id)
+			}
+			assert.Equal(t, tt.expectedControls, controls)
+		})
+	}
+}
+
 func TestBasicMapper_MapUnmapped(t *testing.T) {
 	basicMapper := NewBasicMapper()
 	evidence := api.Evidence{
 		PolicyEngineName:       "test-policy-engine",
 		PolicyRuleId:           "AC-1",
-		PolicyEvaluationStatus: api.Failed,
+		PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusFailed,
 		Timestamp:              time.Now(),
 	}
 	scope := make(mapper.Scope)
 
-	compliance := basicMapper.Map(evidence, scope)
+	compliance, err := basicMapper.Map(evidence, scope)
+	require.NoError(t, err)
 
 	// For basic mapper without plans, we expect an empty compliance object
 	// with only enrichment status set to "unmapped"
@@ -141,6 +829,46 @@ func TestBasicMapper_MapUnmapped(t *testing.T) {
 	assert.Equal(t, api.ComplianceStatusUnknown, compliance.Status)
 }
 
+func TestBasicMapper_MapUnmappedDefaults(t *testing.T) {
+	evidence := api.Evidence{
+		PolicyEngineName:       "test-policy-engine",
+		PolicyRuleId:           "AC-1",
+		PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusFailed,
+		Timestamp:              time.Now(),
+	}
+
+	tests := []struct {
+		name             string
+		opts             []Option
+		expectedCatalog  string
+		expectedCategory string
+	}{
+		{
+			name:             "built-in placeholders",
+			expectedCatalog:  "UNMAPPED",
+			expectedCategory: "UNCATEGORIZED",
+		},
+		{
+			name:             "configured defaults",
+			opts:             []Option{WithUnmappedDefaults("uncategorized-catalog", "Uncategorized")},
+			expectedCatalog:  "uncategorized-catalog",
+			expectedCategory: "Uncategorized",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compliance, err := NewBasicMapper(tt.opts...).Map(evidence, make(mapper.Scope))
+			require.NoError(t, err)
+
+			assert.Equal(t, api.ComplianceEnrichmentStatusUnmapped, compliance.EnrichmentStatus)
+			assert.Equal(t, tt.expectedCatalog, compliance.Control.CatalogId)
+			assert.Equal(t, tt.expectedCategory, compliance.Control.Category)
+			assert.Equal(t, "UNMAPPED", compliance.Control.Id)
+		})
+	}
+}
+
 func TestBasicMapper_AddEvaluationPlan(t *testing.T) {
 	t.Run("adds evaluation plan", func(t *testing.T) {
 		basicMapper := NewBasicMapper()
@@ -174,3 +902,248 @@ func TestBasicMapper_AddEvaluationPlan(t *testing.T) {
 		assert.Equal(t, "AC-2", basicMapper.plans["test-catalog"][1].Control.ReferenceId)
 	})
 }
+
+func TestBasicMapper_RulesForControl(t *testing.T) {
+	basicMapper := NewBasicMapper()
+	basicMapper.AddEvaluationPlan("test-catalog",
+		layer4.AssessmentPlan{
+			Control: layer4.Mapping{EntryId: "AC-1", ReferenceId: "test-catalog"},
+			Assessments: []layer4.Assessment{
+				{
+					Requirement: layer4.Mapping{EntryId: "AC-1.1", ReferenceId: "test-catalog"},
+					Procedures:  []layer4.AssessmentProcedure{{Id: "deny-root-user"}, {Id: "branch-protection"}},
+				},
+				{
+					Requirement: layer4.Mapping{EntryId: "AC-1.2", ReferenceId: "test-catalog"},
+					Procedures:  []layer4.AssessmentProcedure{{Id: "require-mfa"}},
+				},
+			},
+		},
+		layer4.AssessmentPlan{
+			Control: layer4.Mapping{EntryId: "AC-2", ReferenceId: "test-catalog"},
+			Assessments: []layer4.Assessment{
+				{
+					Requirement: layer4.Mapping{EntryId: "AC-2.1", ReferenceId: "test-catalog"},
+					Procedures:  []layer4.AssessmentProcedure{{Id: "audit-logging"}},
+				},
+			},
+		},
+	)
+
+	tests := []struct {
+		name      string
+		catalogId string
+		controlId string
+		expected  []string
+	}{
+		{
+			name:      "control with multiple rules",
+			catalogId: "test-catalog",
+			controlId: "AC-1",
+			expected:  []string{"branch-protection", "deny-root-user", "require-mfa"},
+		},
+		{
+			name:      "control without rules",
+			catalogId: "test-catalog",
+			controlId: "AC-3",
+			expected:  []string{},
+		},
+		{
+			name:      "unknown catalog",
+			catalogId: "other-catalog",
+			controlId: "AC-1",
+			expected:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, basicMapper.RulesForControl(tt.catalogId, tt.controlId))
+		})
+	}
+}
+
+func TestBasicMapper_Validate(t *testing.T) {
+	plan := func(controlId string, procedureIds ...string) layer4.AssessmentPlan {
+		var procedures []layer4.AssessmentProcedure
+		for _, id := range procedureIds {
+			procedures = append(procedures, layer4.AssessmentProcedure{Id: id})
+		}
+		return layer4.AssessmentPlan{
+			Control: layer4.Mapping{EntryId: controlId, ReferenceId: "test-catalog"},
+			Assessments: []layer4.Assessment{
+				{
+					Requirement: layer4.Mapping{EntryId: controlId + "-REQ", ReferenceId: "test-catalog"},
+					Procedures:  procedures,
+				},
+			},
+		}
+	}
+
+	t.Run("unique procedure IDs are valid", func(t *testing.T) {
+		basicMapper := NewBasicMapper()
+		basicMapper.AddEvaluationPlan("test-catalog", plan("AC-1", "proc-1", "proc-2"), plan("AC-2", "proc-3"))
+		// The same procedure ID may be used in another catalog.
+		basicMapper.AddEvaluationPlan("other-catalog", plan("AC-1", "proc-1"))
+
+		assert.NoError(t, basicMapper.Validate())
+	})
+
+	t.Run("colliding procedure IDs are reported", func(t *testing.T) {
+		basicMapper := NewBasicMapper()
+		basicMapper.AddEvaluationPlan("test-catalog", plan("AC-1", "proc-1", "proc-2"))
+		basicMapper.AddEvaluationPlan("test-catalog", plan("AC-2", "proc-2", "proc-1", "proc-2"))
+		basicMapper.AddEvaluationPlan("other-catalog", plan("AC-1", "proc-9", "proc-9"))
+
+		err := basicMapper.Validate()
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "catalog other-catalog: duplicate procedure IDs: proc-9")
+		assert.ErrorContains(t, err, "catalog test-catalog: duplicate procedure IDs: proc-2, proc-1")
+	})
+}
+
+func TestBasicMapper_ConcurrentAddAndMap(t *testing.T) {
+	basicMapper := NewBasicMapper()
+	scope := mapper.Scope{
+		"test-catalog": layer2.Catalog{
+			Metadata: layer2.Metadata{Id: "test-catalog"},
+			ControlFamilies: []layer2.ControlFamily{
+				{Title: "Access Control", Controls: []layer2.Control{{Id: "AC-1"}}},
+			},
+		},
+	}
+
+	const writers, plansPerWriter = 4, 50
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < plansPerWriter; i++ {
+				basicMapper.AddEvaluationPlan("test-catalog", layer4.AssessmentPlan{
+					Control: layer4.Mapping{EntryId: "AC-1", ReferenceId: "test-catalog"},
+					Assessments: []layer4.Assessment{
+						{
+							Requirement: layer4.Mapping{EntryId: "AC-1-REQ", ReferenceId: "test-catalog"},
+							Procedures:  []layer4.AssessmentProcedure{{Id: fmt.Sprintf("proc-%d-%d", w, i)}},
+						},
+					},
+				})
+			}
+		}(w)
+	}
+	for r := 0; r < writers; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < plansPerWriter; i++ {
+				_, err := basicMapper.Map(api.Evidence{
+					PolicyEngineName:       "test-policy-engine",
+					PolicyRuleId:           "proc-0-0",
+					PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
+					Timestamp:              time.Now(),
+				}, scope)
+				assert.NoError(t, err)
+				_ = basicMapper.Procedures()
+			}
+		}()
+	}
+	wg.Wait()
+
+	procedures := basicMapper.Procedures()
+	assert.Len(t, procedures["test-catalog"], writers*plansPerWriter)
+
+	compliance, err := basicMapper.Map(api.Evidence{
+		PolicyEngineName:       "test-policy-engine",
+		PolicyRuleId:           "proc-3-49",
+		PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
+		Timestamp:              time.Now(),
+	}, scope)
+	require.NoError(t, err)
+	assert.Equal(t, api.ComplianceEnrichmentStatusSuccess, compliance.EnrichmentStatus)
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+// benchmarkCatalog returns a catalog of families*controls controls, each mapped
+// to two frameworks, with an assessment plan that checks every control with
+// one procedure.
+func benchmarkCatalog(catalogId string, families, controls int) (layer2.Catalog, []layer4.AssessmentPlan) {
+	catalog := layer2.Catalog{Metadata: layer2.Metadata{Id: catalogId, Version: "1.0.0"}}
+	var plans []layer4.AssessmentPlan
+	for f := range families {
+		family := layer2.ControlFamily{Title: fmt.Sprintf("Family %d", f)}
+		for c := range controls {
+			controlId := fmt.Sprintf("CTRL-%d-%d", f, c)
+			family.Controls = append(family.Controls, layer2.Control{
+				Id:    controlId,
+				Title: "Control " + controlId,
+				GuidelineMappings: []layer2.Mapping{
+					{ReferenceId: "NIST-800-53", Entries: []layer2.MappingEntry{{ReferenceId: "AC-2"}, {ReferenceId: "AC-3"}}},
+					{ReferenceId: "ISO-27001", Entries: []layer2.MappingEntry{{ReferenceId: "A.9.1.1"}}},
+				},
+			})
+			plans = append(plans, layer4.AssessmentPlan{
+				Control: layer4.Mapping{EntryId: controlId, ReferenceId: catalogId},
+				Assessments: []layer4.Assessment{
+					{
+						Requirement: layer4.Mapping{EntryId: controlId + ".1", ReferenceId: catalogId},
+						Procedures: []layer4.AssessmentProcedure{
+							{Id: "rule-" + controlId, Documentation: "Check " + controlId},
+						},
+					},
+				},
+			})
+		}
+		catalog.ControlFamilies = append(catalog.ControlFamilies, family)
+	}
+	return catalog, plans
+}
+
+// BenchmarkBasicMapper_Map measures mapping one piece of evidence against
+// catalogs of 1000 controls each.
+func BenchmarkBasicMapper_Map(b *testing.B) {
+	// Unmapped evidence logs a warning per catalog; keep it out of the results.
+	log.SetOutput(io.Discard)
+	b.Cleanup(func() { log.SetOutput(os.Stderr) })
+
+	scope := mapper.Scope{}
+	newMapper := func(opts ...Option) *Mapper {
+		basicMapper := NewBasicMapper(opts...)
+		for _, catalogId := range []string{"catalog-a", "catalog-b"} {
+			catalog, plans := benchmarkCatalog(catalogId, 50, 20)
+			scope[catalogId] = catalog
+			basicMapper.AddEvaluationPlan(catalogId, plans...)
+		}
+		return basicMapper
+	}
+
+	benchmarks := []struct {
+		name   string
+		mapper *Mapper
+		ruleId string
+	}{
+		{name: "matched", mapper: newMapper(), ruleId: "rule-CTRL-49-19"},
+		{name: "aggregation", mapper: newMapper(WithAggregation()), ruleId: "rule-CTRL-49-19"},
+		{name: "unmapped", mapper: newMapper(), ruleId: "unknown-rule"},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			evidence := api.Evidence{
+				Timestamp:              time.Now(),
+				PolicyEngineName:       "opa",
+				PolicyRuleId:           bm.ruleId,
+				PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusFailed,
+			}
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := bm.mapper.Map(evidence, scope); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package basic
+
+import (
+	"math"
+
+	"github.com/complytime/complybeacon/compass/api"
+)
+
+// RiskScoring configures the relative risk score reported with mapped
+// evidence. Weights express importance on a scale from 0 to 1 and are clamped
+// to that range.
+type RiskScoring struct {
+	// FrameworkWeights holds the importance of each framework by ID.
+	FrameworkWeights map[string]float64
+	// DefaultWeight applies to frameworks without an entry in FrameworkWeights.
+	DefaultWeight float64
+	// ControlWeights holds the criticality of each control by catalog control
+	// ID. Controls without an entry are fully critical.
+	ControlWeights map[string]float64
+}
+
+// WithRiskScoring enables relative risk scoring of mapped evidence.
+func WithRiskScoring(scoring RiskScoring) Option {
+	return func(m *Mapper) {
+		m.scoring = &scoring
+	}
+}
+
+// statusRisk is the share of the weighted risk carried by each compliance
+// status. Statuses without an entry are not scored.
+var statusRisk = map[api.ComplianceStatus]float64{
+	api.ComplianceStatusNonCompliant:  1,
+	api.ComplianceStatusNeedsReview:   0.5,
+	api.ComplianceStatusCompliant:     0,
+	api.ComplianceStatusExempt:        0,
+	api.ComplianceStatusNotApplicable: 0,
+}
+
+// score returns the risk score from 0 to 100 for a result with the given
+// status mapped to controlId and frameworks. The most important framework
+// determines the framework weight. It returns false when the status carries
+// no known risk.
+func (s RiskScoring) score(status api.ComplianceStatus, controlId string, frameworks []string) (float64, bool) {
+	share, ok := statusRisk[status]
+	if !ok {
+		return 0, false
+	}
+
+	frameworkWeight := clampWeight(s.DefaultWeight)
+	if len(frameworks) > 0 {
+		frameworkWeight = 0
+		for _, framework := range frameworks {
+			weight, ok := s.FrameworkWeights[framework]
+			if !ok {
+				weight = s.DefaultWeight
+			}
+			frameworkWeight = math.Max(frameworkWeight, clampWeight(weight))
+		}
+	}
+
+	controlWeight := 1.0
+	if weight, ok := s.ControlWeights[controlId]; ok {
+		controlWeight = clampWeight(weight)
+	}
+
+	score := 100 * share * frameworkWeight * controlWeight
+	return math.Round(score*10) / 10, true
+}
+
+// clampWeight limits weight to the range from 0 to 1.
+func clampWeight(weight float64) float64 {
+	return math.Min(math.Max(weight, 0), 1)
+}
@@ -0,0 +1,179 @@
+package basic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ossf/gemara/layer2"
+	"github.com/ossf/gemara/layer4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/complytime/complybeacon/compass/api"
+	"github.com/complytime/complybeacon/compass/mapper"
+)
+
+// riskTestScope returns a catalog whose AC-1 control maps to NIST-800-53 and
+// ISO-27001 and whose AC-2 control maps to no framework.
+func riskTestScope() mapper.Scope {
+	return mapper.Scope{
+		"test-catalog": layer2.Catalog{
+			Metadata: layer2.Metadata{Id: "test-catalog"},
+			ControlFamilies: []layer2.ControlFamily{
+				{
+					Title: "Access Control",
+					Controls: []layer2.Control{
+						{
+							Id: "AC-1",
+							GuidelineMappings: []layer2.Mapping{
+								{ReferenceId: "NIST-800-53", Entries: []layer2.MappingEntry{{ReferenceId: "AC-1"}}},
+								{ReferenceId: "ISO-27001", Entries: []layer2.MappingEntry{{ReferenceId: "A.9.1.1"}}},
+							},
+						},
+						{Id: "AC-2"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func addRiskTestPlans(m *Mapper) {
+	for _, controlId := range []string{"AC-1", "AC-2"} {
+		m.AddEvaluationPlan("test-catalog", layer4.AssessmentPlan{
+			Control: layer4.Mapping{EntryId: controlId, ReferenceId: "test-catalog"},
+			Assessments: []layer4.Assessment{
+				{
+					Requirement: layer4.Mapping{EntryId: controlId + "-REQ", ReferenceId: "test-catalog"},
+					Procedures:  []layer4.AssessmentProcedure{{Id: controlId + "-PROC"}},
+				},
+			},
+		})
+	}
+}
+
+func TestBasicMapper_WithRiskScoring(t *testing.T) {
+	tests := []struct {
+		name          string
+		scoring       RiskScoring
+		policyRuleId  string
+		status        api.EvidencePolicyEvaluationStatus
+		expectedScore *float64
+	}{
+		{
+			name: "highest framework weight is used",
+			scoring: RiskScoring{
+				FrameworkWeights: map[string]float64{"NIST-800-53": 0.8, "ISO-27001": 0.4},
+				DefaultWeight:    0.5,
+			},
+			policyRuleId:  "AC-1-PROC",
+			status:        api.EvidencePolicyEvaluationStatusFailed,
+			expectedScore: float64Ptr(80),
+		},
+		{
+			name: "unweighted frameworks use the default weight",
+			scoring: RiskScoring{
+				FrameworkWeights: map[string]float64{"ISO-27001": 0.4},
+				DefaultWeight:    0.6,
+			},
+			policyRuleId:  "AC-1-PROC",
+			status:        api.EvidencePolicyEvaluationStatusFailed,
+			expectedScore: float64Ptr(60),
+		},
+		{
+			name: "control criticality scales the score",
+			scoring: RiskScoring{
+				FrameworkWeights: map[string]float64{"NIST-800-53": 0.8},
+				ControlWeights:   map[string]float64{"AC-1": 0.5},
+			},
+			policyRuleId:  "AC-1-PROC",
+			status:        api.EvidencePolicyEvaluationStatusFailed,
+			expectedScore: float64Ptr(40),
+		},
+		{
+			name: "results needing review carry half the risk",
+			scoring: RiskScoring{
+				FrameworkWeights: map[string]float64{"NIST-800-53": 0.8},
+			},
+			policyRuleId:  "AC-1-PROC",
+			status:        api.EvidencePolicyEvaluationStatusNeedsReview,
+			expectedScore: float64Ptr(40),
+		},
+		{
+			name: "compliant results carry no risk",
+			scoring: RiskScoring{
+				FrameworkWeights: map[string]float64{"NIST-800-53": 0.8},
+			},
+			policyRuleId:  "AC-1-PROC",
+			status:        api.EvidencePolicyEvaluationStatusPassed,
+			expectedScore: float64Ptr(0),
+		},
+		{
+			name: "controls without frameworks use the default weight",
+			scoring: RiskScoring{
+				DefaultWeight: 0.25,
+			},
+			policyRuleId:  "AC-2-PROC",
+			status:        api.EvidencePolicyEvaluationStatusFailed,
+			expectedScore: float64Ptr(25),
+		},
+		{
+			name: "weights are clamped",
+			scoring: RiskScoring{
+				FrameworkWeights: map[string]float64{"NIST-800-53": 3},
+			},
+			policyRuleId:  "AC-1-PROC",
+			status:        api.EvidencePolicyEvaluationStatusFailed,
+			expectedScore: float64Ptr(100),
+		},
+		{
+			name: "unknown results are not scored",
+			scoring: RiskScoring{
+				DefaultWeight: 1,
+			},
+			policyRuleId: "AC-1-PROC",
+			status:       api.EvidencePolicyEvaluationStatusUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			basicMapper := NewBasicMapper(WithRiskScoring(tt.scoring))
+			addRiskTestPlans(basicMapper)
+
+			compliance, err := basicMapper.Map(api.Evidence{
+				PolicyEngineName:       "test-policy-engine",
+				PolicyRuleId:           tt.policyRuleId,
+				PolicyEvaluationStatus: tt.status,
+				Timestamp:              time.Now(),
+			}, riskTestScope())
+			require.NoError(t, err)
+
+			if tt.expectedScore == nil {
+				assert.Nil(t, compliance.Risk)
+				return
+			}
+			require.NotNil(t, compliance.Risk)
+			require.NotNil(t, compliance.Risk.Score)
+			assert.InDelta(t, *tt.expectedScore, *compliance.Risk.Score, 0.01)
+		})
+	}
+}
+
+func TestBasicMapper_WithoutRiskScoring(t *testing.T) {
+	basicMapper := NewBasicMapper()
+	addRiskTestPlans(basicMapper)
+
+	compliance, err := basicMapper.Map(api.Evidence{
+		PolicyEngineName:       "test-policy-engine",
+		PolicyRuleId:           "AC-1-PROC",
+		PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusFailed,
+		Timestamp:              time.Now(),
+	}, riskTestScope())
+	require.NoError(t, err)
+	assert.Nil(t, compliance.Risk)
+}
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
@@ -0,0 +1,70 @@
+package cel
+
+import (
+	"strings"
+
+	"github.com/complytime/complybeacon/compass/api"
+	"github.com/complytime/complybeacon/compass/mapper"
+	"github.com/complytime/complybeacon/compass/mapper/plugins/basic"
+)
+
+// A CEL mapper handles evidence from CEL-expression policy engines, which report
+// a boolean outcome in the raw data instead of the evaluation status vocabulary.
+// The outcome is translated to an evaluation status and mapped by the basic mapper.
+
+var (
+	_  mapper.Mapper = (*Mapper)(nil)
+	ID               = mapper.NewID("cel")
+)
+
+// resultKey is the raw data field holding the outcome of the CEL expression.
+const resultKey = "result"
+
+type Mapper struct {
+	*basic.Mapper
+}
+
+// NewCELMapper returns a CEL mapper. Options configure the underlying basic mapper.
+func NewCELMapper(opts ...basic.Option) *Mapper {
+	return &Mapper{
+		Mapper: basic.NewBasicMapper(opts...),
+	}
+}
+
+func (m *Mapper) PluginName() mapper.ID {
+	return ID
+}
+
+func (m *Mapper) Map(evidence api.Evidence, scope mapper.Scope) (api.Compliance, error) {
+	evidence.PolicyEvaluationStatus = parseResult(evidence)
+	return m.Mapper.Map(evidence, scope)
+}
+
+// parseResult translates the boolean outcome in the raw data to an evaluation
+// status. "true" passed, "false" failed, and "error" or any other value is
+// unknown. Evidence without an outcome keeps its reported status.
+func parseResult(evidence api.Evidence) api.EvidencePolicyEvaluationStatus {
+	if evidence.RawData == nil {
+		return evidence.PolicyEvaluationStatus
+	}
+	result, ok := (*evidence.RawData)[resultKey]
+	if !ok {
+		return evidence.PolicyEvaluationStatus
+	}
+
+	switch result := result.(type) {
+	case bool:
+		if result {
+			return api.EvidencePolicyEvaluationStatusPassed
+		}
+		return api.EvidencePolicyEvaluationStatusFailed
+	case string:
+		switch strings.ToLower(strings.TrimSpace(result)) {
+		case "true":
+			return api.EvidencePolicyEvaluationStatusPassed
+		case "false":
+			return api.EvidencePolicyEvaluationStatusFailed
+		}
+	}
+	return api.EvidencePolicyEvaluationStatusUnknown
+}
@@ -0,0 +1,108 @@
+package cel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ossf/gemara/layer2"
+	"github.com/ossf/gemara/layer4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/complytime/complybeacon/compass/api"
+	"github.com/complytime/complybeacon/compass/mapper"
+)
+
+func TestNewCELMapper(t *testing.T) {
+	celMapper := NewCELMapper()
+
+	assert.NotNil(t, celMapper)
+	assert.Equal(t, ID, celMapper.PluginName())
+}
+
+func TestCELMapper_Map(t *testing.T) {
+	tests := []struct {
+		name           string
+		rawData        *map[string]interface{}
+		status         api.EvidencePolicyEvaluationStatus
+		expectedStatus api.ComplianceStatus
+	}{
+		{
+			name:           "true result is compliant",
+			rawData:        &map[string]interface{}{"result": "true", "expression": "object.spec.replicas >= 2"},
+			expectedStatus: api.ComplianceStatusCompliant,
+		},
+		{
+			name:           "false result is non-compliant",
+			rawData:        &map[string]interface{}{"result": "false"},
+			expectedStatus: api.ComplianceStatusNonCompliant,
+		},
+		{
+			name:           "error result is unknown",
+			rawData:        &map[string]interface{}{"result": "error"},
+			status:         api.EvidencePolicyEvaluationStatusPassed,
+			expectedStatus: api.ComplianceStatusUnknown,
+		},
+		{
+			name:           "boolean result is supported",
+			rawData:        &map[string]interface{}{"result": false},
+			expectedStatus: api.ComplianceStatusNonCompliant,
+		},
+		{
+			name:           "evaluation status is kept without a result",
+			status:         api.EvidencePolicyEvaluationStatusPassed,
+			expectedStatus: api.ComplianceStatusCompliant,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			celMapper := NewCELMapper()
+			celMapper.AddEvaluationPlan("test-catalog", layer4.AssessmentPlan{
+				Control: layer4.Mapping{EntryId: "AC-1", ReferenceId: "test-catalog"},
+				Assessments: []layer4.Assessment{
+					{
+						Requirement: layer4.Mapping{EntryId: "AC-1-REQ", ReferenceId: "test-catalog"},
+						Procedures: []layer4.AssessmentProcedure{
+							{Id: "replicas-check", Documentation: "Run at least two replicas"},
+						},
+					},
+				},
+			})
+
+			catalog := layer2.Catalog{
+				Metadata: layer2.Metadata{Id: "test-catalog"},
+				ControlFamilies: []layer2.ControlFamily{
+					{
+						Title: "Availability",
+						Controls: []layer2.Control{
+							{
+								Id: "AC-1",
+								GuidelineMappings: []layer2.Mapping{
+									{
+										ReferenceId: "NIST-800-53",
+										Entries:     []layer2.MappingEntry{{ReferenceId: "AC-1"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			evidence := api.Evidence{
+				PolicyEngineName:       "cel",
+				PolicyRuleId:           "replicas-check",
+				PolicyEvaluationStatus: tt.status,
+				RawData:                tt.rawData,
+				Timestamp:              time.Now(),
+			}
+
+			compliance, err := celMapper.Map(evidence, mapper.Scope{"test-catalog": catalog})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, compliance.Status)
+			assert.Equal(t, api.ComplianceEnrichmentStatusSuccess, compliance.EnrichmentStatus)
+			assert.Equal(t, "AC-1-REQ", compliance.Control.Id)
+		})
+	}
+}
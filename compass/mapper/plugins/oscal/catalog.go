@@ -0,0 +1,242 @@
+package oscal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ossf/gemara/layer2"
+	"github.com/ossf/gemara/layer4"
+)
+
+// Property names read from OSCAL controls.
+const (
+	// labelProp holds the human-readable control label, such as "AC-2(1)".
+	labelProp = "label"
+	// ruleIDProp links a policy rule to the control it assesses.
+	ruleIDProp = "rule-id"
+)
+
+// Part names read from OSCAL controls.
+const (
+	statementPart = "statement"
+	guidancePart  = "guidance"
+)
+
+// Catalog is the subset of an OSCAL catalog used for control mapping.
+// See https://pages.nist.gov/OSCAL/reference/latest/catalog/json-reference/
+type Catalog struct {
+	UUID     string    `json:"uuid"`
+	Metadata Metadata  `json:"metadata"`
+	Groups   []Group   `json:"groups,omitempty"`
+	Controls []Control `json:"controls,omitempty"`
+}
+
+// Metadata is the OSCAL catalog metadata.
+type Metadata struct {
+	Title        string `json:"title"`
+	Version      string `json:"version,omitempty"`
+	LastModified string `json:"last-modified,omitempty"`
+}
+
+// Group is an OSCAL control group, such as a control family.
+type Group struct {
+	ID       string    `json:"id,omitempty"`
+	Title    string    `json:"title"`
+	Groups   []Group   `json:"groups,omitempty"`
+	Controls []Control `json:"controls,omitempty"`
+}
+
+// Control is an OSCAL control. Nested controls are control enhancements.
+type Control struct {
+	ID       string     `json:"id"`
+	Title    string     `json:"title"`
+	Params   []Param    `json:"params,omitempty"`
+	Props    []Property `json:"props,omitempty"`
+	Parts    []Part     `json:"parts,omitempty"`
+	Controls []Control  `json:"controls,omitempty"`
+}
+
+// Param is an OSCAL control parameter, such as an organization-defined
+// frequency or threshold.
+type Param struct {
+	ID     string   `json:"id"`
+	Label  string   `json:"label,omitempty"`
+	Values []string `json:"values,omitempty"`
+}
+
+// Property is an OSCAL name/value property.
+type Property struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Part is a named section of OSCAL control text.
+type Part struct {
+	ID    string `json:"id,omitempty"`
+	Name  string `json:"name"`
+	Prose string `json:"prose,omitempty"`
+	Parts []Part `json:"parts,omitempty"`
+}
+
+// ParseCatalog decodes an OSCAL catalog document in JSON format.
+func ParseCatalog(data []byte) (Catalog, error) {
+	var document struct {
+		Catalog *Catalog `json:"catalog"`
+	}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return Catalog{}, fmt.Errorf("failed to decode OSCAL catalog: %w", err)
+	}
+	if document.Catalog == nil {
+		return Catalog{}, errors.New("document does not contain an OSCAL catalog")
+	}
+	return *document.Catalog, nil
+}
+
+// ToLayer2 converts the OSCAL catalog into a layer2 Catalog with the given ID.
+// Each group becomes a control family, with nested groups and control
+// enhancements flattened into it. Controls outside any group are placed in a
+// family titled after the catalog. Each control maps to the catalog itself as
+// the framework, with the control label as the requirement.
+func (c Catalog) ToLayer2(catalogId string) layer2.Catalog {
+	catalog := layer2.Catalog{
+		Metadata: layer2.Metadata{
+			Id:           catalogId,
+			Title:        c.Metadata.Title,
+			Version:      c.Metadata.Version,
+			LastModified: c.Metadata.LastModified,
+		},
+	}
+	if len(c.Controls) > 0 {
+		catalog.ControlFamilies = append(catalog.ControlFamilies, layer2.ControlFamily{
+			Title:    c.Metadata.Title,
+			Controls: convertControls(catalogId, c.Controls),
+		})
+	}
+	for _, group := range c.Groups {
+		catalog.ControlFamilies = append(catalog.ControlFamilies, layer2.ControlFamily{
+			Id:       group.ID,
+			Title:    group.Title,
+			Controls: convertControls(catalogId, groupControls(group)),
+		})
+	}
+	return catalog
+}
+
+// AssessmentPlans returns an assessment plan for every control that lists
+// policy rules in its rule-id properties.
+func (c Catalog) AssessmentPlans(catalogId string) []layer4.AssessmentPlan {
+	var plans []layer4.AssessmentPlan
+	controls := flattenControls(c.Controls)
+	for _, group := range c.Groups {
+		controls = append(controls, flattenControls(groupControls(group))...)
+	}
+	for _, control := range controls {
+		var procedures []layer4.AssessmentProcedure
+		for _, prop := range control.Props {
+			if prop.Name != ruleIDProp {
+				continue
+			}
+			procedures = append(procedures, layer4.AssessmentProcedure{
+				Id:            prop.Value,
+				Name:          prop.Value,
+				Documentation: control.prose(guidancePart),
+			})
+		}
+		if len(procedures) == 0 {
+			continue
+		}
+		plans = append(plans, layer4.AssessmentPlan{
+			Control: layer4.Mapping{ReferenceId: catalogId, EntryId: control.ID},
+			Assessments: []layer4.Assessment{
+				{
+					Requirement: layer4.Mapping{ReferenceId: catalogId, EntryId: control.label()},
+					Procedures:  procedures,
+				},
+			},
+		})
+	}
+	return plans
+}
+
+// ControlParameters returns the parameter values of every control that sets
+// them, keyed by control ID and then by parameter ID. Parameters with several
+// values are joined with commas; parameters without values are omitted.
+func (c Catalog) ControlParameters() map[string]map[string]string {
+	parameters := make(map[string]map[string]string)
+	controls := flattenControls(c.Controls)
+	for _, group := range c.Groups {
+		controls = append(controls, flattenControls(groupControls(group))...)
+	}
+	for _, control := range controls {
+		for _, param := range control.Params {
+			if len(param.Values) == 0 {
+				continue
+			}
+			if parameters[control.ID] == nil {
+				parameters[control.ID] = make(map[string]string)
+			}
+			parameters[control.ID][param.ID] = strings.Join(param.Values, ", ")
+		}
+	}
+	return parameters
+}
+
+// groupControls returns the controls of a group and all of its nested groups.
+func groupControls(group Group) []Control {
+	controls := append([]Control{}, group.Controls...)
+	for _, nested := range group.Groups {
+		controls = append(controls, groupControls(nested)...)
+	}
+	return controls
+}
+
+// flattenControls returns the controls with their enhancements inlined.
+func flattenControls(controls []Control) []Control {
+	var flattened []Control
+	for _, control := range controls {
+		flattened = append(flattened, control)
+		flattened = append(flattened, flattenControls(control.Controls)...)
+	}
+	return flattened
+}
+
+// convertControls converts controls and their enhancements to layer2 controls.
+func convertControls(catalogId string, controls []Control) []layer2.Control {
+	var converted []layer2.Control
+	for _, control := range flattenControls(controls) {
+		converted = append(converted, layer2.Control{
+			Id:        control.ID,
+			Title:     control.Title,
+			Objective: control.prose(statementPart),
+			GuidelineMappings: []layer2.Mapping{
+				{
+					ReferenceId: catalogId,
+					Entries:     []layer2.MappingEntry{{ReferenceId: control.label()}},
+				},
+			},
+		})
+	}
+	return converted
+}
+
+// label returns the control's label property, falling back to its ID.
+func (c Control) label() string {
+	for _, prop := range c.Props {
+		if prop.Name == labelProp && prop.Value != "" {
+			return prop.Value
+		}
+	}
+	return c.ID
+}
+
+// prose returns the top-level prose of the first part with the given name.
+func (c Control) prose(name string) string {
+	for _, part := range c.Parts {
+		if part.Name == name {
+			return part.Prose
+		}
+	}
+	return ""
+}
@@ -0,0 +1,44 @@
+package oscal
+
+import (
+	"github.com/complytime/complybeacon/compass/mapper"
+	"github.com/complytime/complybeacon/compass/mapper/plugins/basic"
+)
+
+// An OSCAL mapper resolves policy rules against control catalogs maintained in
+// OSCAL. The catalogs themselves are converted with Catalog.ToLayer2 and added
+// to the scope shared by the service, while the mapper holds the evaluation
+// plans and parameters derived from them. Mapping is done by the basic mapper,
+// so evaluation plans added with AddEvaluationPlan work as well.
+
+var (
+	_  mapper.Mapper = (*Mapper)(nil)
+	ID               = mapper.NewID("oscal")
+)
+
+type Mapper struct {
+	*basic.Mapper
+}
+
+// NewOSCALMapper returns an OSCAL mapper. Options configure the underlying basic mapper.
+func NewOSCALMapper(opts ...basic.Option) *Mapper {
+	return &Mapper{
+		Mapper: basic.NewBasicMapper(opts...),
+	}
+}
+
+func (m *Mapper) PluginName() mapper.ID {
+	return ID
+}
+
+// AddCatalog registers the OSCAL catalog with the ID catalogId. Policy rules
+// listed in the rule-id properties of its controls are added as evaluation
+// plans, and control parameter values are reported as the catalog defaults of
+// the controls they set. The converted catalog must be in the scope passed to
+// Map.
+func (m *Mapper) AddCatalog(catalogId string, catalog Catalog) {
+	if plans := catalog.AssessmentPlans(catalogId); len(plans) > 0 {
+		m.AddEvaluationPlan(catalogId, plans...)
+	}
+	m.AddCatalogParameters(catalogId, catalog.ControlParameters())
+}
@@ -0,0 +1,178 @@
+package oscal
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ossf/gemara/layer2"
+	"github.com/ossf/gemara/layer4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/complytime/complybeacon/compass/api"
+	"github.com/complytime/complybeacon/compass/mapper"
+)
+
+func loadTestCatalog(t *testing.T) Catalog {
+	t.Helper()
+	data, err := os.ReadFile("testdata/catalog.json")
+	require.NoError(t, err)
+	catalog, err := ParseCatalog(data)
+	require.NoError(t, err)
+	return catalog
+}
+
+func TestNewOSCALMapper(t *testing.T) {
+	oscalMapper := NewOSCALMapper()
+
+	assert.NotNil(t, oscalMapper)
+	assert.Equal(t, ID, oscalMapper.PluginName())
+}
+
+func TestParseCatalog(t *testing.T) {
+	catalog := loadTestCatalog(t)
+	assert.Equal(t, "Example Security Controls", catalog.Metadata.Title)
+	assert.Len(t, catalog.Groups, 2)
+
+	_, err := ParseCatalog([]byte(`{"profile": {}}`))
+	assert.ErrorContains(t, err, "does not contain an OSCAL catalog")
+
+	_, err = ParseCatalog([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+func TestCatalogToLayer2(t *testing.T) {
+	catalog := loadTestCatalog(t).ToLayer2("EXAMPLE")
+
+	assert.Equal(t, "EXAMPLE", catalog.Metadata.Id)
+	assert.Equal(t, "1.0.0", catalog.Metadata.Version)
+	require.Len(t, catalog.ControlFamilies, 2)
+
+	accessControl := catalog.ControlFamilies[0]
+	assert.Equal(t, "Access Control", accessControl.Title)
+	require.Len(t, accessControl.Controls, 2, "enhancements are flattened into the family")
+	assert.Equal(t, "ac-2", accessControl.Controls[0].Id)
+	assert.Equal(t, "Manage system accounts.", accessControl.Controls[0].Objective)
+	assert.Equal(t, "ac-2.1", accessControl.Controls[1].Id)
+	assert.Equal(t, []layer2.Mapping{
+		{ReferenceId: "EXAMPLE", Entries: []layer2.MappingEntry{{ReferenceId: "AC-2(1)"}}},
+	}, accessControl.Controls[1].GuidelineMappings)
+
+	assert.Equal(t, "Configuration Management", catalog.ControlFamilies[1].Title)
+}
+
+func TestCatalogAssessmentPlans(t *testing.T) {
+	plans := loadTestCatalog(t).AssessmentPlans("EXAMPLE")
+
+	require.Len(t, plans, 2, "only controls with rule-id properties have plans")
+	assert.Equal(t, layer4.Mapping{ReferenceId: "EXAMPLE", EntryId: "ac-2"}, plans[0].Control)
+	assert.Equal(t, "AC-2", plans[0].Assessments[0].Requirement.EntryId)
+	assert.Equal(t, "accounts-reviewed", plans[0].Assessments[0].Procedures[0].Id)
+	assert.Equal(t, "Review accounts at least quarterly.", plans[0].Assessments[0].Procedures[0].Documentation)
+	assert.Equal(t, "accounts-automated", plans[1].Assessments[0].Procedures[0].Id)
+}
+
+func TestCatalogControlParameters(t *testing.T) {
+	parameters := loadTestCatalog(t).ControlParameters()
+
+	assert.Equal(t, map[string]map[string]string{
+		"ac-2": {"ac-2_prm_1": "quarterly", "ac-2_prm_2": "service, shared"},
+	}, parameters, "parameters without values are omitted")
+}
+
+func TestOSCALMapper_Map(t *testing.T) {
+	tests := []struct {
+		name               string
+		ruleId             string
+		expectedControl    string
+		expectedCategory   string
+		expectedEnrichment api.ComplianceEnrichmentStatus
+		expectedParameters *map[string]string
+	}{
+		{
+			name:               "rule on a control",
+			ruleId:             "accounts-reviewed",
+			expectedControl:    "AC-2",
+			expectedCategory:   "Access Control",
+			expectedEnrichment: api.ComplianceEnrichmentStatusSuccess,
+			expectedParameters: &map[string]string{"ac-2_prm_1": "quarterly", "ac-2_prm_2": "service, shared"},
+		},
+		{
+			name:               "rule on a control enhancement",
+			ruleId:             "accounts-automated",
+			expectedControl:    "AC-2(1)",
+			expectedCategory:   "Access Control",
+			expectedEnrichment: api.ComplianceEnrichmentStatusSuccess,
+		},
+		{
+			name:               "unknown rule is unmapped",
+			ruleId:             "unknown-rule",
+			expectedControl:    "UNMAPPED",
+			expectedCategory:   "UNCATEGORIZED",
+			expectedEnrichment: api.ComplianceEnrichmentStatusUnmapped,
+		},
+	}
+
+	catalog := loadTestCatalog(t)
+	scope := mapper.Scope{"EXAMPLE": catalog.ToLayer2("EXAMPLE")}
+	oscalMapper := NewOSCALMapper()
+	oscalMapper.AddCatalog("EXAMPLE", catalog)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compliance, err := oscalMapper.Map(api.Evidence{
+				PolicyRuleId:           tt.ruleId,
+				PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusFailed,
+			}, scope)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedEnrichment, compliance.EnrichmentStatus)
+			assert.Equal(t, tt.expectedControl, compliance.Control.Id)
+			assert.Equal(t, tt.expectedCategory, compliance.Control.Category)
+			if tt.expectedEnrichment == api.ComplianceEnrichmentStatusSuccess {
+				assert.Equal(t, api.ComplianceStatusNonCompliant, compliance.Status)
+				assert.Equal(t, "EXAMPLE", compliance.Control.CatalogId)
+				assert.Equal(t, []string{"EXAMPLE"}, compliance.Frameworks.Frameworks)
+				assert.Equal(t, []string{tt.expectedControl}, compliance.Frameworks.Requirements)
+			}
+			assert.Equal(t, tt.expectedParameters, compliance.Control.Parameters)
+		})
+	}
+}
+
+func TestOSCALMapper_CoexistsWithEvaluationPlans(t *testing.T) {
+	catalog := loadTestCatalog(t)
+	scope := mapper.Scope{"EXAMPLE": catalog.ToLayer2("EXAMPLE")}
+	oscalMapper := NewOSCALMapper()
+	oscalMapper.AddCatalog("EXAMPLE", catalog)
+	oscalMapper.AddEvaluationPlan("EXAMPLE", layer4.AssessmentPlan{
+		Control: layer4.Mapping{ReferenceId: "EXAMPLE", EntryId: "cm-6"},
+		Assessments: []layer4.Assessment{
+			{
+				Requirement: layer4.Mapping{ReferenceId: "EXAMPLE", EntryId: "CM-6"},
+				Procedures:  []layer4.AssessmentProcedure{{Id: "settings-hardened"}},
+			},
+		},
+	})
+
+	compliance, err := oscalMapper.Map(api.Evidence{
+		PolicyRuleId:           "settings-hardened",
+		PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
+	}, scope)
+	require.NoError(t, err)
+	assert.Equal(t, "CM-6", compliance.Control.Id)
+	assert.Equal(t, "Configuration Management", compliance.Control.Category)
+	assert.Equal(t, api.ComplianceStatusCompliant, compliance.Status)
+}
+
+func TestOSCALMapper_CatalogOutsideScope(t *testing.T) {
+	oscalMapper := NewOSCALMapper()
+	oscalMapper.AddCatalog("EXAMPLE", loadTestCatalog(t))
+
+	compliance, err := oscalMapper.Map(api.Evidence{
+		PolicyRuleId:           "accounts-reviewed",
+		PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusFailed,
+	}, mapper.Scope{})
+	require.NoError(t, err)
+	assert.Equal(t, api.ComplianceEnrichmentStatusUnmapped, compliance.EnrichmentStatus)
+}
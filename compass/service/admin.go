@@ -0,0 +1,70 @@
+package service
+
+import (
+	"crypto/subtle"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gin-contrib/requestid"
+	"github.com/gin-gonic/gin"
+
+	"github.com/complytime/complybeacon/compass/api"
+	"github.com/complytime/complybeacon/compass/mapper"
+)
+
+// WithCatalogReload enables POST /v1/admin/reload. The endpoint calls load
+// to read the catalogs again and requires adminToken as a bearer token.
+// Without an admin token the endpoint stays disabled.
+func WithCatalogReload(load func() (mapper.Scope, error), adminToken string) Option {
+	return func(s *Service) {
+		s.loadScope = load
+		s.adminToken = adminToken
+	}
+}
+
+// PostV1AdminReload handles the POST /v1/admin/reload endpoint.
+// It reloads the catalogs and swaps them in for subsequent requests. When
+// loading fails, the current catalogs remain in use.
+func (s *Service) PostV1AdminReload(c *gin.Context) {
+	if s.adminToken == "" || s.loadScope == nil {
+		sendCompassError(c, http.StatusNotFound, ReasonAdminDisabled, "Admin endpoints are not enabled")
+		return
+	}
+	if !s.authorized(c.GetHeader("Authorization")) {
+		slog.Warn("unauthorized admin request",
+			slog.String("request_id", requestid.Get(c)),
+			slog.String("path", c.Request.URL.Path),
+		)
+		c.Header("WWW-Authenticate", "Bearer")
+		sendCompassError(c, http.StatusUnauthorized, ReasonUnauthorized, "Invalid admin token")
+		return
+	}
+
+	scope, err := s.loadScope()
+	if err != nil {
+		slog.Error("failed to reload catalogs",
+			slog.String("request_id", requestid.Get(c)),
+			slog.String("error", err.Error()),
+		)
+		sendCompassError(c, http.StatusInternalServerError, ReasonReloadFailed, "Failed to reload catalogs")
+		return
+	}
+	s.scope.Store(&scope)
+
+	slog.Info("catalogs reloaded",
+		slog.String("request_id", requestid.Get(c)),
+		slog.Int("catalogs", len(scope)),
+	)
+	respond(c, http.StatusOK, api.ReloadResponse{Catalogs: len(scope)})
+}
+
+// authorized reports whether the Authorization header carries the admin
+// token. Tokens are compared in constant time.
+func (s *Service) authorized(header string) bool {
+	token, ok := strings.CutPrefix(header, "Bearer ")
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(s.adminToken)) == 1
+}
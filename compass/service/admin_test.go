@@ -0,0 +1,108 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ossf/gemara/layer2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/complytime/complybeacon/compass/api"
+	"github.com/complytime/complybeacon/compass/mapper"
+)
+
+func TestPostV1AdminReload(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	initial := mapper.Scope{"initial": layer2.Catalog{Metadata: layer2.Metadata{Id: "initial"}}}
+	reloaded := mapper.Scope{
+		"osps": layer2.Catalog{Metadata: layer2.Metadata{Id: "osps"}},
+		"nist": layer2.Catalog{Metadata: layer2.Metadata{Id: "nist"}},
+	}
+
+	tests := []struct {
+		name           string
+		adminToken     string
+		load           func() (mapper.Scope, error)
+		authorization  string
+		expectedCode   int
+		expectedReason string
+		expectedScope  mapper.Scope
+	}{
+		{
+			name:          "Authorized reload swaps the catalogs",
+			adminToken:    "secret",
+			load:          func() (mapper.Scope, error) { return reloaded, nil },
+			authorization: "Bearer secret",
+			expectedCode:  http.StatusOK,
+			expectedScope: reloaded,
+		},
+		{
+			name:           "Missing token is unauthorized",
+			adminToken:     "secret",
+			load:           func() (mapper.Scope, error) { return reloaded, nil },
+			expectedCode:   http.StatusUnauthorized,
+			expectedReason: ReasonUnauthorized,
+			expectedScope:  initial,
+		},
+		{
+			name:           "Wrong token is unauthorized",
+			adminToken:     "secret",
+			load:           func() (mapper.Scope, error) { return reloaded, nil },
+			authorization:  "Bearer guess",
+			expectedCode:   http.StatusUnauthorized,
+			expectedReason: ReasonUnauthorized,
+			expectedScope:  initial,
+		},
+		{
+			name:           "Load error keeps the current catalogs",
+			adminToken:     "secret",
+			load:           func() (mapper.Scope, error) { return nil, errors.New("invalid catalog") },
+			authorization:  "Bearer secret",
+			expectedCode:   http.StatusInternalServerError,
+			expectedReason: ReasonReloadFailed,
+			expectedScope:  initial,
+		},
+		{
+			name:           "Without an admin token the endpoint is disabled",
+			load:           func() (mapper.Scope, error) { return reloaded, nil },
+			authorization:  "Bearer ",
+			expectedCode:   http.StatusNotFound,
+			expectedReason: ReasonAdminDisabled,
+			expectedScope:  initial,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewService(make(mapper.Set), initial, WithCatalogReload(tt.load, tt.adminToken))
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/v1/admin/reload", nil)
+			if tt.authorization != "" {
+				c.Request.Header.Set("Authorization", tt.authorization)
+			}
+
+			service.PostV1AdminReload(c)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.expectedScope, service.currentScope())
+			if tt.expectedReason != "" {
+				var errRes api.Error
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errRes))
+				require.NotNil(t, errRes.Reason)
+				assert.Equal(t, tt.expectedReason, *errRes.Reason)
+				return
+			}
+			var response api.ReloadResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, len(tt.expectedScope), response.Catalogs)
+		})
+	}
+}
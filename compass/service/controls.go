@@ -0,0 +1,48 @@
+package service
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/complytime/complybeacon/compass/api"
+)
+
+// ruleLister is implemented by mappers that can list the policy rules they
+// resolve to a control, such as the basic mapper and the plugins built on it.
+type ruleLister interface {
+	RulesForControl(catalogId, controlId string) []string
+}
+
+// GetV1ControlsIdRules handles the GET /v1/controls/{id}/rules endpoint.
+// It lists the policy rules each registered mapper resolves to the control,
+// for every catalog in scope. Mappers that cannot list rules are omitted.
+func (s *Service) GetV1ControlsIdRules(c *gin.Context, id string, params api.GetV1ControlsIdRulesParams) {
+	catalogIds := slices.Sorted(maps.Keys(s.currentScope()))
+	if params.CatalogId != nil {
+		catalogIds = []string{*params.CatalogId}
+	}
+
+	response := api.ControlRulesResponse{
+		ControlId: id,
+		Rules:     []api.ControlRule{},
+	}
+	for _, mapperId := range s.set.IDs() {
+		lister, ok := s.set[mapperId].(ruleLister)
+		if !ok {
+			continue
+		}
+		for _, catalogId := range catalogIds {
+			for _, rule := range lister.RulesForControl(catalogId, id) {
+				response.Rules = append(response.Rules, api.ControlRule{
+					Mapper:       string(mapperId),
+					CatalogId:    catalogId,
+					PolicyRuleId: rule,
+				})
+			}
+		}
+	}
+	respond(c, http.StatusOK, response)
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ossf/gemara/layer2"
+	"github.com/ossf/gemara/layer4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/complytime/complybeacon/compass/api"
+	"github.com/complytime/complybeacon/compass/mapper"
+	"github.com/complytime/complybeacon/compass/mapper/plugins/basic"
+)
+
+func TestGetV1ControlsIdRules(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mapperPlugin := basic.NewBasicMapper()
+	for _, catalogId := range []string{"test-catalog", "other-catalog"} {
+		mapperPlugin.AddEvaluationPlan(catalogId, layer4.AssessmentPlan{
+			Control: layer4.Mapping{EntryId: "AC-1", ReferenceId: catalogId},
+			Assessments: []layer4.Assessment{
+				{
+					Requirement: layer4.Mapping{EntryId: "AC-1.1", ReferenceId: catalogId},
+					Procedures:  []layer4.AssessmentProcedure{{Id: "deny-root-user"}, {Id: "branch-protection"}},
+				},
+			},
+		})
+	}
+	set := mapper.Set{
+		"opa":   mapperPlugin,
+		"other": &countingMapper{},
+	}
+	catalogFilter := "test-catalog"
+	scope := mapper.Scope{
+		"test-catalog":  layer2.Catalog{Metadata: layer2.Metadata{Id: "test-catalog"}},
+		"other-catalog": layer2.Catalog{Metadata: layer2.Metadata{Id: "other-catalog"}},
+	}
+
+	tests := []struct {
+		name      string
+		controlId string
+		catalogId *string
+		expected  []api.ControlRule
+	}{
+		{
+			name:      "Control with multiple rules",
+			controlId: "AC-1",
+			expected: []api.ControlRule{
+				{Mapper: "opa", CatalogId: "other-catalog", PolicyRuleId: "branch-protection"},
+				{Mapper: "opa", CatalogId: "other-catalog", PolicyRuleId: "deny-root-user"},
+				{Mapper: "opa", CatalogId: "test-catalog", PolicyRuleId: "branch-protection"},
+				{Mapper: "opa", CatalogId: "test-catalog", PolicyRuleId: "deny-root-user"},
+			},
+		},
+		{
+			name:      "Catalog filter",
+			controlId: "AC-1",
+			catalogId: &catalogFilter,
+			expected: []api.ControlRule{
+				{Mapper: "opa", CatalogId: "test-catalog", PolicyRuleId: "branch-protection"},
+				{Mapper: "opa", CatalogId: "test-catalog", PolicyRuleId: "deny-root-user"},
+			},
+		},
+		{
+			name:      "Control without rules",
+			controlId: "AC-2",
+			expected:  []api.ControlRule{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewService(set, scope)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/v1/controls/"+tt.controlId+"/rules", nil)
+
+			service.GetV1ControlsIdRules(c, tt.controlId, api.GetV1ControlsIdRulesParams{CatalogId: tt.catalogId})
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			var response api.ControlRulesResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tt.controlId, response.ControlId)
+			assert.Equal(t, tt.expected, response.Rules)
+		})
+	}
+}
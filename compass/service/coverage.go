@@ -0,0 +1,57 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/complytime/complybeacon/compass/api"
+)
+
+// GetV1Coverage handles the GET /v1/coverage endpoint.
+// It reports which controls in the catalog at least one registered mapper
+// resolves a policy rule to, and which controls no policy rule assesses.
+func (s *Service) GetV1Coverage(c *gin.Context, params api.GetV1CoverageParams) {
+	catalog, ok := s.currentScope()[params.Catalog]
+	if !ok {
+		sendCompassError(c, http.StatusNotFound, ReasonCatalogNotFound, fmt.Sprintf("Unknown catalog %q", params.Catalog))
+		return
+	}
+
+	response := api.CoverageResponse{
+		CatalogId:         params.Catalog,
+		CoveredControls:   []string{},
+		UncoveredControls: []string{},
+	}
+	for _, family := range catalog.ControlFamilies {
+		for _, control := range family.Controls {
+			if s.controlCovered(params.Catalog, control.Id) {
+				response.CoveredControls = append(response.CoveredControls, control.Id)
+			} else {
+				response.UncoveredControls = append(response.UncoveredControls, control.Id)
+			}
+		}
+	}
+	slices.Sort(response.CoveredControls)
+	slices.Sort(response.UncoveredControls)
+
+	if total := len(response.CoveredControls) + len(response.UncoveredControls); total > 0 {
+		percent := 100 * float64(len(response.CoveredControls)) / float64(total)
+		response.CoveragePercent = math.Round(percent*10) / 10
+	}
+	respond(c, http.StatusOK, response)
+}
+
+// controlCovered reports whether any mapper in the set resolves at least one
+// policy rule to the control.
+func (s *Service) controlCovered(catalogId, controlId string) bool {
+	for _, m := range s.set {
+		if lister, ok := m.(ruleLister); ok && len(lister.RulesForControl(catalogId, controlId)) > 0 {
+			return true
+		}
+	}
+	return false
+}
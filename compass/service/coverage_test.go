@@ -0,0 +1,125 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ossf/gemara/layer2"
+	"github.com/ossf/gemara/layer4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/complytime/complybeacon/compass/api"
+	"github.com/complytime/complybeacon/compass/mapper"
+	"github.com/complytime/complybeacon/compass/mapper/plugins/basic"
+)
+
+func TestGetV1Coverage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	opaMapper := basic.NewBasicMapper()
+	opaMapper.AddEvaluationPlan("test-catalog", layer4.AssessmentPlan{
+		Control: layer4.Mapping{EntryId: "AC-1", ReferenceId: "test-catalog"},
+		Assessments: []layer4.Assessment{
+			{
+				Requirement: layer4.Mapping{EntryId: "AC-1.1", ReferenceId: "test-catalog"},
+				Procedures:  []layer4.AssessmentProcedure{{Id: "deny-root-user"}},
+			},
+		},
+	})
+	conformaMapper := basic.NewBasicMapper()
+	conformaMapper.AddEvaluationPlan("test-catalog", layer4.AssessmentPlan{
+		Control: layer4.Mapping{EntryId: "CM-2", ReferenceId: "test-catalog"},
+		Assessments: []layer4.Assessment{
+			{
+				Requirement: layer4.Mapping{EntryId: "CM-2.1", ReferenceId: "test-catalog"},
+				Procedures:  []layer4.AssessmentProcedure{{Id: "signed-images"}},
+			},
+		},
+	})
+	set := mapper.Set{
+		"opa":      opaMapper,
+		"conforma": conformaMapper,
+		"other":    &countingMapper{},
+	}
+	scope := mapper.Scope{
+		"test-catalog": layer2.Catalog{
+			Metadata: layer2.Metadata{Id: "test-catalog"},
+			ControlFamilies: []layer2.ControlFamily{
+				{
+					Title:    "Access Control",
+					Controls: []layer2.Control{{Id: "AC-2"}, {Id: "AC-1"}},
+				},
+				{
+					Title:    "Configuration Management",
+					Controls: []layer2.Control{{Id: "CM-2"}},
+				},
+			},
+		},
+		"empty-catalog": layer2.Catalog{Metadata: layer2.Metadata{Id: "empty-catalog"}},
+	}
+
+	tests := []struct {
+		name           string
+		catalog        string
+		expectedCode   int
+		expectedReason string
+		expected       api.CoverageResponse
+	}{
+		{
+			name:         "Partially covered catalog",
+			catalog:      "test-catalog",
+			expectedCode: http.StatusOK,
+			expected: api.CoverageResponse{
+				CatalogId:         "test-catalog",
+				CoveredControls:   []string{"AC-1", "CM-2"},
+				UncoveredControls: []string{"AC-2"},
+				CoveragePercent:   66.7,
+			},
+		},
+		{
+			name:         "Catalog without controls",
+			catalog:      "empty-catalog",
+			expectedCode: http.StatusOK,
+			expected: api.CoverageResponse{
+				CatalogId:         "empty-catalog",
+				CoveredControls:   []string{},
+				UncoveredControls: []string{},
+			},
+		},
+		{
+			name:           "Unknown catalog",
+			catalog:        "missing-catalog",
+			expectedCode:   http.StatusNotFound,
+			expectedReason: ReasonCatalogNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewService(set, scope)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/v1/coverage?catalog="+tt.catalog, nil)
+
+			service.GetV1Coverage(c, api.GetV1CoverageParams{Catalog: tt.catalog})
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			if tt.expectedReason != "" {
+				var apiErr api.Error
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &apiErr))
+				require.NotNil(t, apiErr.Reason)
+				assert.Equal(t, tt.expectedReason, *apiErr.Reason)
+				return
+			}
+
+			var response api.CoverageResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tt.expected, response)
+		})
+	}
+}
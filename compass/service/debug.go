@@ -0,0 +1,72 @@
+package service
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/complytime/complybeacon/compass/api"
+	"github.com/complytime/complybeacon/compass/mapper/plugins/basic"
+)
+
+// procedureLister is implemented by mappers that can report the procedures
+// they resolve policy rules against, such as the basic mapper and the
+// plugins built on it.
+type procedureLister interface {
+	Procedures() map[string]map[string]basic.ProcedureInfo
+}
+
+// WithDebugEndpoints enables the debug endpoints, such as GET /v1/debug/plans.
+// They expose mapper internals and are meant for troubleshooting.
+func WithDebugEndpoints() Option {
+	return func(s *Service) {
+		s.debug = true
+	}
+}
+
+// GetV1DebugPlans handles the GET /v1/debug/plans endpoint.
+// It lists the procedures each registered mapper loaded, per catalog.
+// Mappers that cannot report their procedures are omitted.
+func (s *Service) GetV1DebugPlans(c *gin.Context) {
+	if !s.debug {
+		sendCompassError(c, http.StatusNotFound, ReasonDebugDisabled, "Debug endpoints are not enabled")
+		return
+	}
+
+	response := api.PlansResponse{Mappers: []api.MapperPlans{}}
+	for _, id := range s.set.IDs() {
+		lister, ok := s.set[id].(procedureLister)
+		if !ok {
+			continue
+		}
+		response.Mappers = append(response.Mappers, api.MapperPlans{
+			Mapper:   string(id),
+			Catalogs: catalogProcedures(lister.Procedures()),
+		})
+	}
+	respond(c, http.StatusOK, response)
+}
+
+// catalogProcedures converts procedures keyed by catalog and procedure ID
+// into the sorted API representation.
+func catalogProcedures(procedures map[string]map[string]basic.ProcedureInfo) []api.CatalogProcedures {
+	catalogs := make([]api.CatalogProcedures, 0, len(procedures))
+	for _, catalogId := range slices.Sorted(maps.Keys(procedures)) {
+		byId := procedures[catalogId]
+		catalog := api.CatalogProcedures{
+			CatalogId:  catalogId,
+			Procedures: make([]api.ProcedureMapping, 0, len(byId)),
+		}
+		for _, procedureId := range slices.Sorted(maps.Keys(byId)) {
+			catalog.Procedures = append(catalog.Procedures, api.ProcedureMapping{
+				Id:            procedureId,
+				ControlId:     byId[procedureId].ControlID,
+				RequirementId: byId[procedureId].RequirementID,
+			})
+		}
+		catalogs = append(catalogs, catalog)
+	}
+	return catalogs
+}
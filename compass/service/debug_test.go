@@ -0,0 +1,93 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ossf/gemara/layer4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/complytime/complybeacon/compass/api"
+	"github.com/complytime/complybeacon/compass/mapper"
+	"github.com/complytime/complybeacon/compass/mapper/plugins/basic"
+)
+
+func TestGetV1DebugPlans(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mapperPlugin := basic.NewBasicMapper()
+	mapperPlugin.AddEvaluationPlan("test-catalog", layer4.AssessmentPlan{
+		Control: layer4.Mapping{EntryId: "AC-1", ReferenceId: "test-catalog"},
+		Assessments: []layer4.Assessment{
+			{
+				Requirement: layer4.Mapping{EntryId: "AC-1.1", ReferenceId: "test-catalog"},
+				Procedures:  []layer4.AssessmentProcedure{{Id: "deny-root-user"}, {Id: "branch-protection"}},
+			},
+		},
+	})
+	set := mapper.Set{
+		"opa":   mapperPlugin,
+		"other": &countingMapper{},
+	}
+
+	tests := []struct {
+		name         string
+		opts         []Option
+		expectedCode int
+	}{
+		{
+			name:         "Debug endpoints enabled",
+			opts:         []Option{WithDebugEndpoints()},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Debug endpoints disabled",
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewService(set, make(mapper.Scope), tt.opts...)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/v1/debug/plans", nil)
+
+			service.GetV1DebugPlans(c)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			if tt.expectedCode != http.StatusOK {
+				var apiErr api.Error
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &apiErr))
+				require.NotNil(t, apiErr.Reason)
+				assert.Equal(t, ReasonDebugDisabled, *apiErr.Reason)
+				return
+			}
+
+			var response api.PlansResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			expected := api.PlansResponse{
+				Mappers: []api.MapperPlans{
+					{
+						Mapper: "opa",
+						Catalogs: []api.CatalogProcedures{
+							{
+								CatalogId: "test-catalog",
+								Procedures: []api.ProcedureMapping{
+									{Id: "branch-protection", ControlId: "AC-1", RequirementId: "AC-1.1"},
+									{Id: "deny-root-user", ControlId: "AC-1", RequirementId: "AC-1.1"},
+								},
+							},
+						},
+					},
+				},
+			}
+			assert.Equal(t, expected, response)
+		})
+	}
+}
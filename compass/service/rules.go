@@ -0,0 +1,83 @@
+package service
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/complytime/complybeacon/compass/api"
+	"github.com/complytime/complybeacon/compass/mapper"
+	"github.com/complytime/complybeacon/compass/mapper/plugins/basic"
+)
+
+// GetV1Rules handles the GET /v1/rules endpoint.
+// It lists the policy rules each registered mapper and each fallback mapper
+// resolve against a catalog in scope, and the mapper ID compass reports for
+// evidence none of them maps. The response is incomplete when a mapper cannot
+// report its procedures.
+func (s *Service) GetV1Rules(c *gin.Context) {
+	scope := s.currentScope()
+	unmappedMapperIds := make(map[string]string, len(s.set))
+	response := api.RulesResponse{
+		Engines:           make(map[string][]string, len(s.set)),
+		FallbackRules:     []string{},
+		Complete:          true,
+		UnmappedMapperIds: &unmappedMapperIds,
+	}
+	for _, id := range s.set.IDs() {
+		rules, ok := mapperRules(s.set[id], scope)
+		if !ok {
+			response.Complete = false
+		}
+		response.Engines[string(id)] = rules
+		unmappedMapperIds[string(id)] = string(s.unmappedMapperID(s.set[id]))
+	}
+	if !s.strict {
+		unmappedMapperId := string(s.unmappedMapperID(nil))
+		response.UnmappedMapperId = &unmappedMapperId
+	}
+	for _, fallback := range s.fallbacks {
+		rules, ok := mapperRules(fallback, scope)
+		if !ok {
+			response.Complete = false
+		}
+		response.FallbackRules = append(response.FallbackRules, rules...)
+	}
+	slices.Sort(response.FallbackRules)
+	response.FallbackRules = slices.Compact(response.FallbackRules)
+	respond(c, http.StatusOK, response)
+}
+
+// unmappedMapperID returns the ID of the last mapper mapperChain tries, with
+// fallbacks enabled, for evidence from an engine with registered as its
+// mapper; registered is nil for an unregistered engine. Compass reports this
+// ID for evidence no mapper maps.
+func (s *Service) unmappedMapperID(registered mapper.Mapper) mapper.ID {
+	if len(s.fallbacks) > 0 {
+		return s.fallbacks[len(s.fallbacks)-1].PluginName()
+	}
+	if registered != nil {
+		return registered.PluginName()
+	}
+	return basic.ID
+}
+
+// mapperRules returns the sorted policy rule IDs m resolves against catalogs
+// in scope, and false when m cannot report its procedures.
+func mapperRules(m mapper.Mapper, scope mapper.Scope) ([]string, bool) {
+	lister, ok := m.(procedureLister)
+	if !ok {
+		return []string{}, false
+	}
+	rules := []string{}
+	for catalogId, procedures := range lister.Procedures() {
+		if _, ok := scope[catalogId]; !ok {
+			continue
+		}
+		rules = slices.AppendSeq(rules, maps.Keys(procedures))
+	}
+	slices.Sort(rules)
+	return slices.Compact(rules), true
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ossf/gemara/layer2"
+	"github.com/ossf/gemara/layer4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/complytime/complybeacon/compass/api"
+	"github.com/complytime/complybeacon/compass/mapper"
+	"github.com/complytime/complybeacon/compass/mapper/plugins/basic"
+)
+
+// newRulesMapper returns a basic mapper that resolves rules to a control in
+// catalogId.
+func newRulesMapper(catalogId string, rules ...string) *basic.Mapper {
+	procedures := make([]layer4.AssessmentProcedure, 0, len(rules))
+	for _, rule := range rules {
+		procedures = append(procedures, layer4.AssessmentProcedure{Id: rule})
+	}
+	m := basic.NewBasicMapper()
+	m.AddEvaluationPlan(catalogId, layer4.AssessmentPlan{
+		Control: layer4.Mapping{EntryId: "AC-1", ReferenceId: catalogId},
+		Assessments: []layer4.Assessment{
+			{
+				Requirement: layer4.Mapping{EntryId: "AC-1.1", ReferenceId: catalogId},
+				Procedures:  procedures,
+			},
+		},
+	})
+	return m
+}
+
+func TestGetV1Rules(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	scope := mapper.Scope{
+		"test-catalog": layer2.Catalog{Metadata: layer2.Metadata{Id: "test-catalog"}},
+	}
+
+	tests := []struct {
+		name     string
+		set      mapper.Set
+		opts     []Option
+		expected api.RulesResponse
+	}{
+		{
+			name: "No registered mappers",
+			set:  make(mapper.Set),
+			expected: api.RulesResponse{
+				Engines:           map[string][]string{},
+				FallbackRules:     []string{},
+				Complete:          true,
+				UnmappedMapperIds: &map[string]string{},
+				UnmappedMapperId:  stringPtr("basic"),
+			},
+		},
+		{
+			name: "Rules are listed per engine and for fallbacks",
+			set: mapper.Set{
+				"opa":      newRulesMapper("test-catalog", "require-signed-images", "deny-root-user"),
+				"conforma": newRulesMapper("test-catalog"),
+			},
+			opts: []Option{WithFallbackMappers(
+				newRulesMapper("test-catalog", "github_branch_protection", "deny-root-user"),
+				newRulesMapper("test-catalog", "github_branch_protection"),
+			)},
+			expected: api.RulesResponse{
+				Engines: map[string][]string{
+					"opa":      {"deny-root-user", "require-signed-images"},
+					"conforma": {},
+				},
+				FallbackRules: []string{"deny-root-user", "github_branch_protection"},
+				Complete:      true,
+				UnmappedMapperIds: &map[string]string{
+					"opa":      "basic",
+					"conforma": "basic",
+				},
+				UnmappedMapperId: stringPtr("basic"),
+			},
+		},
+		{
+			name: "Rules for catalogs out of scope are omitted",
+			set: mapper.Set{
+				"opa": newRulesMapper("other-catalog", "deny-root-user"),
+			},
+			expected: api.RulesResponse{
+				Engines:           map[string][]string{"opa": {}},
+				FallbackRules:     []string{},
+				Complete:          true,
+				UnmappedMapperIds: &map[string]string{"opa": "basic"},
+				UnmappedMapperId:  stringPtr("basic"),
+			},
+		},
+		{
+			name: "Mappers that cannot list rules make the response incomplete",
+			set: mapper.Set{
+				"opa":   newRulesMapper("test-catalog", "deny-root-user"),
+				"other": &countingMapper{},
+			},
+			expected: api.RulesResponse{
+				Engines: map[string][]string{
+					"opa":   {"deny-root-user"},
+					"other": {},
+				},
+				FallbackRules: []string{},
+				Complete:      false,
+				UnmappedMapperIds: &map[string]string{
+					"opa":   "basic",
+					"other": "counting",
+				},
+				UnmappedMapperId: stringPtr("basic"),
+			},
+		},
+		{
+			name: "Fallbacks report unmapped evidence under the last fallback",
+			set: mapper.Set{
+				"other": &countingMapper{},
+			},
+			opts: []Option{WithFallbackMappers(&countingMapper{}, newRulesMapper("test-catalog"))},
+			expected: api.RulesResponse{
+				Engines:           map[string][]string{"other": {}},
+				FallbackRules:     []string{},
+				Complete:          false,
+				UnmappedMapperIds: &map[string]string{"other": "basic"},
+				UnmappedMapperId:  stringPtr("basic"),
+			},
+		},
+		{
+			name: "Strict engines omit the mapper for unregistered engines",
+			set: mapper.Set{
+				"other": &countingMapper{},
+			},
+			opts: []Option{WithStrictEngines()},
+			expected: api.RulesResponse{
+				Engines:           map[string][]string{"other": {}},
+				FallbackRules:     []string{},
+				Complete:          false,
+				UnmappedMapperIds: &map[string]string{"other": "counting"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewService(tt.set, scope, tt.opts...)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/v1/rules", nil)
+
+			service.GetV1Rules(c)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			var response api.RulesResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tt.expected, response)
+		})
+	}
+}
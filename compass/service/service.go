@@ -1,8 +1,17 @@
 package service
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"log/slog"
+	"maps"
 	"net/http"
+	"slices"
+	"strings"
+	"sync/atomic"
 
 	"github.com/gin-contrib/requestid"
 	"github.com/gin-gonic/gin"
@@ -12,31 +21,131 @@ import (
 	"github.com/complytime/complybeacon/compass/mapper/plugins/basic"
 )
 
+// Machine-readable reasons reported in api.Error responses.
+const (
+	// ReasonInvalidBody indicates the request body could not be decoded.
+	ReasonInvalidBody = "INVALID_BODY"
+	// ReasonEngineNotFound indicates no mapper is registered for the policy
+	// engine and strict engine checking is enabled.
+	ReasonEngineNotFound = "ENGINE_NOT_FOUND"
+	// ReasonEnrichmentFailed indicates the mapper failed to enrich the evidence.
+	ReasonEnrichmentFailed = "ENRICHMENT_FAILED"
+	// ReasonBodyTooLarge indicates the request body exceeded the size limit.
+	ReasonBodyTooLarge = "BODY_TOO_LARGE"
+	// ReasonUnauthorized indicates a missing or invalid admin token.
+	ReasonUnauthorized = "UNAUTHORIZED"
+	// ReasonAdminDisabled indicates no admin token is configured, so admin
+	// endpoints are unavailable.
+	ReasonAdminDisabled = "ADMIN_DISABLED"
+	// ReasonReloadFailed indicates the catalogs could not be reloaded.
+	ReasonReloadFailed = "RELOAD_FAILED"
+	// ReasonDebugDisabled indicates debug endpoints are not enabled.
+	ReasonDebugDisabled = "DEBUG_DISABLED"
+	// ReasonUnsupportedMediaType indicates the request body is neither JSON
+	// nor YAML.
+	ReasonUnsupportedMediaType = "UNSUPPORTED_MEDIA_TYPE"
+	// ReasonCatalogNotFound indicates the requested catalog is not in scope.
+	ReasonCatalogNotFound = "CATALOG_NOT_FOUND"
+)
+
 // Service struct to hold dependencies if needed
 type Service struct {
-	set   mapper.Set
-	scope mapper.Scope
+	set mapper.Set
+	// scope is replaced as a whole on reload, so each request maps against
+	// a consistent set of catalogs.
+	scope  atomic.Pointer[mapper.Scope]
+	strict bool
+	// fallbacks are tried in order after the engine's own mapper.
+	fallbacks []mapper.Mapper
+	// basicOpts configure the basic mapper used without a mapper or fallbacks.
+	basicOpts []basic.Option
+	// loadScope and adminToken configure catalog reloads.
+	loadScope  func() (mapper.Scope, error)
+	adminToken string
+	// debug enables the debug endpoints.
+	debug bool
+}
+
+// Option configures optional Service behavior.
+type Option func(*Service)
+
+// WithStrictEngines rejects evidence from policy engines without a registered
+// mapper with a 404 instead of falling back to the basic mapper.
+func WithStrictEngines() Option {
+	return func(s *Service) {
+		s.strict = true
+	}
+}
+
+// WithFallbackMappers sets an ordered chain of mappers tried after the
+// mapper registered for the policy engine. The first mapper that maps the
+// evidence wins. Without fallbacks, evidence from an engine without a
+// registered mapper is handled by the basic mapper.
+func WithFallbackMappers(mappers ...mapper.Mapper) Option {
+	return func(s *Service) {
+		s.fallbacks = append(s.fallbacks, mappers...)
+	}
+}
+
+// WithBasicMapperOptions configures the basic mapper used for evidence that
+// has neither a registered mapper nor fallback mappers, such as the catalog
+// and category it reports for unmapped evidence.
+func WithBasicMapperOptions(opts ...basic.Option) Option {
+	return func(s *Service) {
+		s.basicOpts = append(s.basicOpts, opts...)
+	}
 }
 
 // NewService initializes a new Service instance.
-func NewService(transformers mapper.Set, scope mapper.Scope) *Service {
-	return &Service{
-		set:   transformers,
-		scope: scope,
+func NewService(transformers mapper.Set, scope mapper.Scope, opts ...Option) *Service {
+	s := &Service{
+		set: transformers,
 	}
+	s.scope.Store(&scope)
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// currentScope returns the catalogs currently used for mapping.
+func (s *Service) currentScope() mapper.Scope {
+	return *s.scope.Load()
+}
+
+// selectScope restricts scope to the catalogs with the given IDs. Without
+// catalog IDs the whole scope is returned. The first ID not in scope is
+// returned as unknown.
+func selectScope(scope mapper.Scope, catalogIds *[]string) (selected mapper.Scope, unknown string) {
+	if catalogIds == nil || len(*catalogIds) == 0 {
+		return scope, ""
+	}
+	selected = make(mapper.Scope, len(*catalogIds))
+	for _, id := range *catalogIds {
+		catalog, ok := scope[id]
+		if !ok {
+			return nil, id
+		}
+		selected[id] = catalog
+	}
+	return selected, ""
 }
 
 // PostV1Enrich handles the POST /v1/enrich endpoint.
 // It's a handler function for Gin.
 func (s *Service) PostV1Enrich(c *gin.Context) {
 	var req api.EnrichmentRequest
-	err := c.Bind(&req)
+	err := bindRequest(c, &req)
+	if errors.Is(err, errUnsupportedMediaType) {
+		sendUnsupportedMediaType(c, err)
+		return
+	}
 	if err != nil {
 		slog.Warn("invalid enrichment request",
 			slog.String("request_id", requestid.Get(c)),
 			slog.String("error", err.Error()),
 		)
-		sendCompassError(c, http.StatusBadRequest, "Invalid format for enrichment")
+		sendCompassError(c, http.StatusBadRequest, ReasonInvalidBody, "Invalid format for enrichment")
 		return
 	}
 
@@ -47,47 +156,334 @@ func (s *Service) PostV1Enrich(c *gin.Context) {
 		slog.String("timestamp", req.Evidence.Timestamp.String()),
 	)
 
-	mapperPlugin, ok := s.set[mapper.ID(req.Evidence.PolicyEngineName)]
-	if !ok {
-		// Use fallback
-		slog.Warn("mapper not found; using basic mapper fallback",
+	chain, ok := s.mapperChain(req.Evidence.PolicyEngineName, !fallbackDisabled(req.DisableFallback))
+	if !ok && s.strict {
+		slog.Warn("mapper not found; rejecting request in strict mode",
+			slog.String("request_id", requestid.Get(c)),
 			slog.String("policy_engine_name", req.Evidence.PolicyEngineName),
 		)
-		mapperPlugin = basic.NewBasicMapper()
+		sendCompassError(c, http.StatusNotFound, ReasonEngineNotFound, fmt.Sprintf("Unknown policy engine %q", req.Evidence.PolicyEngineName))
+		return
+	}
+
+	scope, unknown := selectScope(s.currentScope(), req.Catalogs)
+	if unknown != "" {
+		slog.Warn("unknown catalog requested",
+			slog.String("request_id", requestid.Get(c)),
+			slog.String("catalog_id", unknown),
+		)
+		sendCompassError(c, http.StatusNotFound, ReasonCatalogNotFound, fmt.Sprintf("Unknown catalog %q", unknown))
+		return
 	}
 
 	slog.Debug("mapper selected",
 		slog.String("request_id", requestid.Get(c)),
-		slog.String("mapper_id", string(mapperPlugin.PluginName())),
+		slog.String("mapper_id", string(chain[0].PluginName())),
+		slog.Int("chain_length", len(chain)),
 		slog.Bool("fallback_used", !ok),
 	)
 
-	enrichedResponse := enrich(req.Evidence, mapperPlugin, s.scope)
+	// Stop early if the client has gone away; there is no one left to
+	// receive the result.
+	if err := c.Request.Context().Err(); err != nil {
+		slog.Warn("enrich request cancelled before mapping",
+			slog.String("request_id", requestid.Get(c)),
+			slog.String("error", err.Error()),
+		)
+		c.Abort()
+		return
+	}
+
+	enrichedResponse, mapperID, err := enrichWithChain(req.Evidence, chain, scope)
+	if err != nil {
+		slog.Error("failed to enrich evidence",
+			slog.String("request_id", requestid.Get(c)),
+			slog.String("mapper_id", string(mapperID)),
+			slog.String("policy_rule_id", req.Evidence.PolicyRuleId),
+			slog.String("error", err.Error()),
+		)
+		sendCompassError(c, http.StatusInternalServerError, ReasonEnrichmentFailed, "Failed to enrich evidence")
+		return
+	}
 
 	slog.Debug("enrich result",
 		slog.String("request_id", requestid.Get(c)),
+		slog.String("mapper_id", string(mapperID)),
 		slog.String("compliance_status", string(enrichedResponse.Compliance.Status)),
 		slog.String("compliance_catalog", enrichedResponse.Compliance.Control.CatalogId),
 		slog.String("compliance_control", enrichedResponse.Compliance.Control.Id),
 	)
 
-	c.JSON(http.StatusOK, enrichedResponse)
+	// The enrichment result only changes when the catalogs or plans change,
+	// so let clients revalidate instead of downloading it again.
+	etag, err := computeETag(enrichedResponse)
+	if err != nil {
+		slog.Warn("failed to compute etag",
+			slog.String("request_id", requestid.Get(c)),
+			slog.String("error", err.Error()),
+		)
+	} else {
+		c.Header("ETag", etag)
+		if etagMatches(c.GetHeader("If-None-Match"), etag) {
+			c.Status(http.StatusNotModified)
+			return
+		}
+	}
+
+	respond(c, http.StatusOK, enrichedResponse)
+}
+
+// PostV1Summary handles the POST /v1/summary endpoint.
+// It maps a batch of evidence and counts the compliance statuses per framework.
+func (s *Service) PostV1Summary(c *gin.Context) {
+	var req api.SummaryRequest
+	err := bindRequest(c, &req)
+	if errors.Is(err, errUnsupportedMediaType) {
+		sendUnsupportedMediaType(c, err)
+		return
+	}
+	if err != nil {
+		slog.Warn("invalid summary request",
+			slog.String("request_id", requestid.Get(c)),
+			slog.String("error", err.Error()),
+		)
+		sendCompassError(c, http.StatusBadRequest, ReasonInvalidBody, "Invalid format for summary")
+		return
+	}
+
+	scope, unknown := selectScope(s.currentScope(), req.Catalogs)
+	if unknown != "" {
+		slog.Warn("unknown catalog requested",
+			slog.String("request_id", requestid.Get(c)),
+			slog.String("catalog_id", unknown),
+		)
+		sendCompassError(c, http.StatusNotFound, ReasonCatalogNotFound, fmt.Sprintf("Unknown catalog %q", unknown))
+		return
+	}
+
+	results := make([]api.Compliance, 0, len(req.Evidence))
+	for _, evidence := range req.Evidence {
+		// Stop early if the client has gone away; there is no one left to
+		// receive the summary.
+		if err := c.Request.Context().Err(); err != nil {
+			slog.Warn("summary request cancelled before mapping",
+				slog.String("request_id", requestid.Get(c)),
+				slog.String("error", err.Error()),
+			)
+			c.Abort()
+			return
+		}
+
+		chain, ok := s.mapperChain(evidence.PolicyEngineName, !fallbackDisabled(req.DisableFallback))
+		if !ok && s.strict {
+			slog.Warn("mapper not found; rejecting request in strict mode",
+				slog.String("request_id", requestid.Get(c)),
+				slog.String("policy_engine_name", evidence.PolicyEngineName),
+			)
+			sendCompassError(c, http.StatusNotFound, ReasonEngineNotFound, fmt.Sprintf("Unknown policy engine %q", evidence.PolicyEngineName))
+			return
+		}
+
+		response, mapperID, err := enrichWithChain(evidence, chain, scope)
+		if err != nil {
+			slog.Error("failed to enrich evidence",
+				slog.String("request_id", requestid.Get(c)),
+				slog.String("mapper_id", string(mapperID)),
+				slog.String("policy_rule_id", evidence.PolicyRuleId),
+				slog.String("error", err.Error()),
+			)
+			sendCompassError(c, http.StatusInternalServerError, ReasonEnrichmentFailed, "Failed to enrich evidence")
+			return
+		}
+		results = append(results, response.Compliance)
+	}
+
+	respond(c, http.StatusOK, summarize(results))
+}
+
+// summarize counts compliance statuses per framework. A result counts once
+// toward each of its frameworks; results without a framework are unmapped.
+func summarize(results []api.Compliance) api.SummaryResponse {
+	counts := make(map[string]map[string]int)
+	unmapped := 0
+	for _, compliance := range results {
+		if compliance.EnrichmentStatus == api.ComplianceEnrichmentStatusUnmapped || len(compliance.Frameworks.Frameworks) == 0 {
+			unmapped++
+			continue
+		}
+		for _, framework := range compliance.Frameworks.Frameworks {
+			if counts[framework] == nil {
+				counts[framework] = make(map[string]int)
+			}
+			counts[framework][string(compliance.Status)]++
+		}
+	}
+
+	summary := api.SummaryResponse{
+		Frameworks: make([]api.FrameworkSummary, 0, len(counts)),
+		Unmapped:   unmapped,
+	}
+	for _, framework := range slices.Sorted(maps.Keys(counts)) {
+		total := 0
+		for _, count := range counts[framework] {
+			total += count
+		}
+		summary.Frameworks = append(summary.Frameworks, api.FrameworkSummary{
+			Framework: framework,
+			Counts:    counts[framework],
+			Total:     total,
+		})
+	}
+	return summary
+}
+
+// mapperChain returns the mappers to try for evidence from engine, and
+// whether a mapper is registered for it. The registered mapper comes first,
+// followed by the fallbacks when fallback is true; without either, the basic
+// mapper is used. In strict mode, an unregistered engine has no chain.
+func (s *Service) mapperChain(engine string, fallback bool) ([]mapper.Mapper, bool) {
+	var chain []mapper.Mapper
+	mapperPlugin, ok := s.set[mapper.ID(engine)]
+	if !ok && s.strict {
+		return nil, false
+	}
+	if ok {
+		chain = append(chain, mapperPlugin)
+	}
+	if !fallback {
+		if !ok {
+			// A basic mapper without plans reports the evidence as unmapped.
+			chain = append(chain, basic.NewBasicMapper(s.basicOpts...))
+		}
+		return chain, ok
+	}
+	chain = append(chain, s.fallbacks...)
+	if len(chain) == 0 {
+		// Use fallback
+		slog.Warn("mapper not found; using basic mapper fallback",
+			slog.String("policy_engine_name", engine),
+		)
+		chain = append(chain, basic.NewBasicMapper(s.basicOpts...))
+	}
+	return chain, ok
+}
+
+// fallbackDisabled reports whether a request opted out of fallback mappers.
+func fallbackDisabled(disableFallback *bool) bool {
+	return disableFallback != nil && *disableFallback
+}
+
+// GetV1Engines handles the GET /v1/engines endpoint.
+// It lists the policy engines that have a dedicated mapper.
+func (s *Service) GetV1Engines(c *gin.Context) {
+	engines := make([]string, 0, len(s.set))
+	for _, id := range s.set.IDs() {
+		engines = append(engines, string(id))
+	}
+	respond(c, http.StatusOK, api.EnginesResponse{Engines: engines})
+}
+
+// GetV1Schema handles the GET /v1/schema endpoint.
+// It lists the telemetry attributes emitted by complybeacon and their meanings.
+func (s *Service) GetV1Schema(c *gin.Context) {
+	attributes := make([]api.AttributeDefinition, 0, len(attributeDescriptions))
+	for _, key := range slices.Sorted(maps.Keys(attributeDescriptions)) {
+		attributes = append(attributes, api.AttributeDefinition{
+			Key:         key,
+			Description: attributeDescriptions[key],
+		})
+	}
+	respond(c, http.StatusOK, api.SchemaResponse{Attributes: attributes})
+}
+
+// computeETag returns a weak entity tag over the JSON encoding of obj. It is
+// weak because JSON and YAML representations of the same result share it.
+func computeETag(obj any) (string, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return `W/"` + hex.EncodeToString(sum[:]) + `"`, nil
+}
+
+// etagMatches reports whether an If-None-Match header value matches etag
+// using weak comparison.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// sendUnsupportedMediaType rejects a request body that is neither JSON nor YAML.
+func sendUnsupportedMediaType(c *gin.Context, err error) {
+	slog.Warn("unsupported request content type",
+		slog.String("request_id", requestid.Get(c)),
+		slog.String("error", err.Error()),
+	)
+	sendCompassError(c, http.StatusUnsupportedMediaType, ReasonUnsupportedMediaType,
+		fmt.Sprintf("Content-Type must be %s or %s", gin.MIMEJSON, mimeYAML))
 }
 
 // sendCompassError wraps sending of an error in the Error format, and
 // handling the failure to marshal that.
-func sendCompassError(c *gin.Context, code int32, message string) {
+func sendCompassError(c *gin.Context, code int32, reason, message string) {
 	compassErr := api.Error{
 		Code:    code,
 		Message: message,
+		Reason:  &reason,
+	}
+	respond(c, int(code), compassErr)
+}
+
+// enrichWithChain enriches the evidence with each mapper in turn through a
+// mapper.CompositeMapper and returns its result along with the ID of the
+// mapper that produced it, which is also recorded in the response. A mapper
+// failure or panic stops the chain and is returned with the ID of the failing
+// mapper.
+func enrichWithChain(rawEnv api.Evidence, chain []mapper.Mapper, scope mapper.Scope) (api.EnrichmentResponse, mapper.ID, error) {
+	guarded := make([]mapper.Mapper, 0, len(chain))
+	for _, attributeMapper := range chain {
+		guarded = append(guarded, guardedMapper{Mapper: attributeMapper})
 	}
-	c.JSON(int(code), compassErr)
+	compliance, id, err := mapper.NewCompositeMapper(guarded...).MapWithID(rawEnv, scope)
+	if err != nil {
+		return api.EnrichmentResponse{}, id, err
+	}
+	mapperID := string(id)
+	return api.EnrichmentResponse{Compliance: compliance, MapperId: &mapperID}, id, nil
+}
+
+// guardedMapper maps through enrich, so a failure or panic of the wrapped
+// mapper is returned as an error naming it.
+type guardedMapper struct {
+	mapper.Mapper
+}
+
+func (g guardedMapper) Map(evidence api.Evidence, scope mapper.Scope) (api.Compliance, error) {
+	response, err := enrich(evidence, g.Mapper, scope)
+	return response.Compliance, err
 }
 
 // Enrich the raw evidence with risk attributes based on `gemara` semantics.
-func enrich(rawEnv api.Evidence, attributeMapper mapper.Mapper, scope mapper.Scope) api.EnrichmentResponse {
-	compliance := attributeMapper.Map(rawEnv, scope)
+// A panic in the mapper plugin is recovered and returned as an error so a
+// single bad plugin cannot take down the server.
+func enrich(rawEnv api.Evidence, attributeMapper mapper.Mapper, scope mapper.Scope) (response api.EnrichmentResponse, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("mapper %s panicked: %v", attributeMapper.PluginName(), r)
+		}
+	}()
+
+	compliance, err := attributeMapper.Map(rawEnv, scope)
+	if err != nil {
+		return api.EnrichmentResponse{}, fmt.Errorf("mapper %s failed: %w", attributeMapper.PluginName(), err)
+	}
 	return api.EnrichmentResponse{
 		Compliance: compliance,
-	}
+	}, nil
 }
@@ -1,11 +1,20 @@
 package service
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-yaml"
 	"github.com/ossf/gemara/layer2"
 	"github.com/ossf/gemara/layer4"
 	"github.com/stretchr/testify/assert"
@@ -24,7 +33,7 @@ func TestNewService(t *testing.T) {
 
 	assert.NotNil(t, service)
 	assert.Equal(t, mappers, service.set)
-	assert.Equal(t, scope, service.scope)
+	assert.Equal(t, scope, service.currentScope())
 }
 
 func TestEnrich(t *testing.T) {
@@ -78,14 +87,15 @@ func TestEnrich(t *testing.T) {
 		evidence := api.Evidence{
 			PolicyEngineName:       "test-policy-engine",
 			PolicyRuleId:           "AC-1",
-			PolicyEvaluationStatus: api.Passed,
+			PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
 			Timestamp:              time.Now(),
 		}
 		scope := mapper.Scope{
 			"test-catalog": catalog,
 		}
 
-		response := enrich(evidence, mapperPlugin, scope)
+		response, err := enrich(evidence, mapperPlugin, scope)
+		require.NoError(t, err)
 
 		assert.Equal(t, api.ComplianceEnrichmentStatusSuccess, response.Compliance.EnrichmentStatus)
 		assert.Equal(t, api.ComplianceStatusCompliant, response.Compliance.Status)
@@ -105,11 +115,12 @@ func TestEnrich(t *testing.T) {
 		evidence := api.Evidence{
 			PolicyEngineName:       "test-policy-engine",
 			PolicyRuleId:           "AC-1",
-			PolicyEvaluationStatus: api.Failed,
+			PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusFailed,
 			Timestamp:              time.Now(),
 		}
 		scope := make(mapper.Scope)
-		response := enrich(evidence, mapperPlugin, scope)
+		response, err := enrich(evidence, mapperPlugin, scope)
+		require.NoError(t, err)
 
 		assert.Equal(t, api.ComplianceEnrichmentStatusUnmapped, response.Compliance.EnrichmentStatus)
 		assert.Equal(t, api.ComplianceStatusUnknown, response.Compliance.Status)
@@ -122,6 +133,1195 @@ func TestEnrich(t *testing.T) {
 	})
 }
 
+func TestEnrichNeedsReview(t *testing.T) {
+	swagger, err := api.GetSwagger()
+	require.NoError(t, err)
+
+	mapperPlugin := basic.NewBasicMapper()
+	mapperPlugin.AddEvaluationPlan("test-catalog", layer4.AssessmentPlan{
+		Control: layer4.Mapping{EntryId: "AC-1", ReferenceId: "test-catalog"},
+		Assessments: []layer4.Assessment{
+			{
+				Requirement: layer4.Mapping{EntryId: "AC-1-REQ", ReferenceId: "test-catalog"},
+				Procedures:  []layer4.AssessmentProcedure{{Id: "AC-1"}},
+			},
+		},
+	})
+	scope := mapper.Scope{
+		"test-catalog": layer2.Catalog{
+			Metadata: layer2.Metadata{Id: "test-catalog"},
+			ControlFamilies: []layer2.ControlFamily{
+				{
+					Title: "Access Control",
+					Controls: []layer2.Control{
+						{
+							Id: "AC-1",
+							GuidelineMappings: []layer2.Mapping{
+								{ReferenceId: "NIST-800-53", Entries: []layer2.MappingEntry{{ReferenceId: "AC-1"}}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	evidence := api.Evidence{
+		PolicyEngineName:       "test-policy-engine",
+		PolicyRuleId:           "AC-1",
+		PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusNeedsReview,
+		Timestamp:              time.Now(),
+	}
+
+	response, err := enrich(evidence, mapperPlugin, scope)
+	require.NoError(t, err)
+
+	assert.Equal(t, api.ComplianceStatusNeedsReview, response.Compliance.Status)
+	assert.Equal(t, api.ComplianceEnrichmentStatusSuccess, response.Compliance.EnrichmentStatus)
+
+	err = validateEnrichmentResponse(t, response, swagger)
+	assert.NoError(t, err, "Needs Review status should validate against OpenAPI schema")
+}
+
+func TestGetV1Engines(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name     string
+		set      mapper.Set
+		expected []string
+	}{
+		{
+			name:     "No registered mappers",
+			set:      make(mapper.Set),
+			expected: []string{},
+		},
+		{
+			name: "Registered mappers are sorted",
+			set: mapper.Set{
+				"opa":      &countingMapper{},
+				"conforma": &countingMapper{},
+			},
+			expected: []string{"conforma", "opa"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewService(tt.set, make(mapper.Scope))
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/v1/engines", nil)
+
+			service.GetV1Engines(c)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			var response api.EnginesResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tt.expected, response.Engines)
+		})
+	}
+}
+
+func TestPostV1EnrichUnknownEngine(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name         string
+		opts         []Option
+		expectedCode int
+	}{
+		{
+			name:         "Lenient mode falls back to basic mapper",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Strict mode rejects unknown engine",
+			opts:         []Option{WithStrictEngines()},
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registered := &countingMapper{}
+			service := NewService(mapper.Set{"test-policy-engine": registered}, make(mapper.Scope), tt.opts...)
+
+			body, err := json.Marshal(api.EnrichmentRequest{
+				Evidence: api.Evidence{
+					PolicyEngineName:       "unknown-engine",
+					PolicyRuleId:           "AC-1",
+					PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
+					Timestamp:              time.Now(),
+				},
+			})
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/v1/enrich", bytes.NewReader(body))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			service.PostV1Enrich(c)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, 0, registered.calls, "registered mapper should not be used for another engine")
+
+			if tt.expectedCode == http.StatusNotFound {
+				var apiErr api.Error
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &apiErr))
+				assert.Equal(t, int32(http.StatusNotFound), apiErr.Code)
+				assert.Contains(t, apiErr.Message, "unknown-engine")
+				require.NotNil(t, apiErr.Reason)
+				assert.Equal(t, ReasonEngineNotFound, *apiErr.Reason)
+				return
+			}
+
+			var response api.EnrichmentResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, api.ComplianceEnrichmentStatusUnmapped, response.Compliance.EnrichmentStatus)
+			require.NotNil(t, response.MapperId)
+			assert.Equal(t, string(basic.ID), *response.MapperId)
+		})
+	}
+}
+
+func TestPostV1EnrichBasicMapperOptions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	service := NewService(make(mapper.Set), make(mapper.Scope),
+		WithBasicMapperOptions(basic.WithUnmappedDefaults("UNMAPPED-CATALOG", "Triage")))
+
+	body, err := json.Marshal(api.EnrichmentRequest{
+		Evidence: api.Evidence{
+			PolicyEngineName:       "unknown-engine",
+			PolicyRuleId:           "AC-1",
+			PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
+			Timestamp:              time.Now(),
+		},
+	})
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/v1/enrich", bytes.NewReader(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	service.PostV1Enrich(c)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var response api.EnrichmentResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, api.ComplianceEnrichmentStatusUnmapped, response.Compliance.EnrichmentStatus)
+	assert.Equal(t, "UNMAPPED-CATALOG", response.Compliance.Control.CatalogId)
+	assert.Equal(t, "Triage", response.Compliance.Control.Category)
+}
+
+func TestPostV1EnrichYAML(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	yamlBody := []byte(`evidence:
+  policyEngineName: test-policy-engine
+  policyRuleId: AC-1
+  policyEvaluationStatus: Passed
+  timestamp: 2025-01-05T12:30:00Z
+`)
+	jsonBody, err := json.Marshal(api.EnrichmentRequest{
+		Evidence: api.Evidence{
+			PolicyEngineName:       "test-policy-engine",
+			PolicyRuleId:           "AC-1",
+			PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
+			Timestamp:              time.Now(),
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		body         []byte
+		contentType  string
+		accept       string
+		expectedCode int
+		expectedType string
+	}{
+		{
+			name:         "YAML in, YAML out",
+			body:         yamlBody,
+			contentType:  "application/yaml",
+			accept:       "application/yaml",
+			expectedCode: http.StatusOK,
+			expectedType: "application/yaml",
+		},
+		{
+			name:         "YAML in, JSON out by default",
+			body:         yamlBody,
+			contentType:  "application/yaml",
+			expectedCode: http.StatusOK,
+			expectedType: "application/json",
+		},
+		{
+			name:         "JSON in, YAML out",
+			body:         jsonBody,
+			contentType:  "application/json",
+			accept:       "application/yaml",
+			expectedCode: http.StatusOK,
+			expectedType: "application/yaml",
+		},
+		{
+			name:         "Malformed YAML",
+			body:         []byte("evidence: [unterminated"),
+			contentType:  "application/yaml",
+			accept:       "application/yaml",
+			expectedCode: http.StatusBadRequest,
+			expectedType: "application/yaml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapperPlugin := &countingMapper{}
+			service := NewService(mapper.Set{"test-policy-engine": mapperPlugin}, make(mapper.Scope))
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/v1/enrich", bytes.NewReader(tt.body))
+			c.Request.Header.Set("Content-Type", tt.contentType)
+			if tt.accept != "" {
+				c.Request.Header.Set("Accept", tt.accept)
+			}
+
+			service.PostV1Enrich(c)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Contains(t, w.Header().Get("Content-Type"), tt.expectedType)
+
+			body := w.Body.Bytes()
+			if tt.expectedType == "application/yaml" {
+				body, err = yaml.YAMLToJSON(body)
+				require.NoError(t, err)
+			}
+
+			if tt.expectedCode != http.StatusOK {
+				var apiErr api.Error
+				require.NoError(t, json.Unmarshal(body, &apiErr))
+				assert.Equal(t, int32(tt.expectedCode), apiErr.Code)
+				assert.Equal(t, "Invalid format for enrichment", apiErr.Message)
+				require.NotNil(t, apiErr.Reason)
+				assert.Equal(t, ReasonInvalidBody, *apiErr.Reason)
+				assert.Equal(t, 0, mapperPlugin.calls)
+				return
+			}
+
+			var response api.EnrichmentResponse
+			require.NoError(t, json.Unmarshal(body, &response))
+			assert.Equal(t, api.ComplianceStatusCompliant, response.Compliance.Status)
+			assert.Equal(t, 1, mapperPlugin.calls)
+		})
+	}
+}
+
+func TestPostContentTypeEnforcement(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	evidence := api.Evidence{
+		PolicyEngineName:       "test-policy-engine",
+		PolicyRuleId:           "AC-1",
+		PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
+		Timestamp:              time.Now(),
+	}
+	enrichBody, err := json.Marshal(api.EnrichmentRequest{Evidence: evidence})
+	require.NoError(t, err)
+	summaryBody, err := json.Marshal(api.SummaryRequest{Evidence: []api.Evidence{evidence}})
+	require.NoError(t, err)
+
+	endpoints := []struct {
+		name   string
+		path   string
+		body   []byte
+		handle func(*Service, *gin.Context)
+	}{
+		{name: "enrich", path: "/v1/enrich", body: enrichBody, handle: (*Service).PostV1Enrich},
+		{name: "summary", path: "/v1/summary", body: summaryBody, handle: (*Service).PostV1Summary},
+	}
+
+	tests := []struct {
+		name         string
+		contentType  string
+		expectedCode int
+	}{
+		{
+			name:         "JSON content type",
+			contentType:  "application/json",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "JSON content type with charset",
+			contentType:  "application/json; charset=utf-8",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Plain text content type",
+			contentType:  "text/plain",
+			expectedCode: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:         "Undeclared YAML content type",
+			contentType:  "application/x-yaml",
+			expectedCode: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:         "Form content type",
+			contentType:  "application/x-www-form-urlencoded",
+			expectedCode: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:         "Missing content type",
+			expectedCode: http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for _, endpoint := range endpoints {
+		for _, tt := range tests {
+			t.Run(endpoint.name+"/"+tt.name, func(t *testing.T) {
+				mapperPlugin := &countingMapper{}
+				service := NewService(mapper.Set{"test-policy-engine": mapperPlugin}, make(mapper.Scope))
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodPost, endpoint.path, bytes.NewReader(endpoint.body))
+				if tt.contentType != "" {
+					c.Request.Header.Set("Content-Type", tt.contentType)
+				}
+
+				endpoint.handle(service, c)
+
+				assert.Equal(t, tt.expectedCode, w.Code)
+				if tt.expectedCode == http.StatusOK {
+					assert.Equal(t, 1, mapperPlugin.calls)
+					return
+				}
+
+				var apiErr api.Error
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &apiErr))
+				assert.Equal(t, int32(http.StatusUnsupportedMediaType), apiErr.Code)
+				require.NotNil(t, apiErr.Reason)
+				assert.Equal(t, ReasonUnsupportedMediaType, *apiErr.Reason)
+				assert.Equal(t, 0, mapperPlugin.calls)
+			})
+		}
+	}
+}
+
+func TestPostV1EnrichConditional(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	body, err := json.Marshal(api.EnrichmentRequest{
+		Evidence: api.Evidence{
+			PolicyEngineName:       "test-policy-engine",
+			PolicyRuleId:           "AC-1",
+			PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
+			Timestamp:              time.Now(),
+		},
+	})
+	require.NoError(t, err)
+
+	service := NewService(mapper.Set{"test-policy-engine": &countingMapper{}}, make(mapper.Scope))
+	post := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/v1/enrich", bytes.NewReader(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		if ifNoneMatch != "" {
+			c.Request.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		service.PostV1Enrich(c)
+		c.Writer.WriteHeaderNow()
+		return w
+	}
+
+	first := post("")
+	require.Equal(t, http.StatusOK, first.Code)
+	etag := first.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	tests := []struct {
+		name         string
+		ifNoneMatch  string
+		expectedCode int
+	}{
+		{
+			name:         "Matching entity tag",
+			ifNoneMatch:  etag,
+			expectedCode: http.StatusNotModified,
+		},
+		{
+			name:         "Matching entity tag in a list",
+			ifNoneMatch:  `"stale", ` + etag,
+			expectedCode: http.StatusNotModified,
+		},
+		{
+			name:         "Non-matching entity tag",
+			ifNoneMatch:  `W/"stale"`,
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := post(tt.ifNoneMatch)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, etag, w.Header().Get("ETag"))
+			if tt.expectedCode == http.StatusNotModified {
+				assert.Empty(t, w.Body.Bytes())
+			} else {
+				assert.Equal(t, first.Body.String(), w.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetV1Schema(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	service := NewService(make(mapper.Set), make(mapper.Scope))
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/v1/schema", nil)
+
+	service.GetV1Schema(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response api.SchemaResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+	descriptions := make(map[string]string, len(response.Attributes))
+	keys := make([]string, 0, len(response.Attributes))
+	for _, attr := range response.Attributes {
+		descriptions[attr.Key] = attr.Description
+		keys = append(keys, attr.Key)
+	}
+	assert.IsIncreasing(t, keys, "attributes should be sorted by key")
+
+	assert.Equal(t, "Unique identifier for the security control catalog or framework", descriptions["compliance.control.catalog.id"])
+	assert.Equal(t, "Category or family that the security control belongs to", descriptions["compliance.control.category"])
+	assert.Contains(t, descriptions, "compliance.status")
+	assert.Contains(t, descriptions, "policy.rule.id")
+}
+
+// statusMapper reports a fixed enrichment status and counts calls.
+func TestPostV1Summary(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mapperPlugin := basic.NewBasicMapper()
+	mapperPlugin.AddEvaluationPlan("test-catalog",
+		layer4.AssessmentPlan{
+			Control: layer4.Mapping{EntryId: "AC-1", ReferenceId: "test-catalog"},
+			Assessments: []layer4.Assessment{
+				{
+					Requirement: layer4.Mapping{EntryId: "AC-1-REQ", ReferenceId: "test-catalog"},
+					Procedures:  []layer4.AssessmentProcedure{{Id: "rule-1"}, {Id: "rule-2"}},
+				},
+			},
+		},
+		layer4.AssessmentPlan{
+			Control: layer4.Mapping{EntryId: "SC-1", ReferenceId: "test-catalog"},
+			Assessments: []layer4.Assessment{
+				{
+					Requirement: layer4.Mapping{EntryId: "SC-1-REQ", ReferenceId: "test-catalog"},
+					Procedures:  []layer4.AssessmentProcedure{{Id: "rule-3"}},
+				},
+			},
+		},
+	)
+	scope := mapper.Scope{
+		"test-catalog": layer2.Catalog{
+			Metadata: layer2.Metadata{Id: "test-catalog"},
+			ControlFamilies: []layer2.ControlFamily{
+				{
+					Title: "Access Control",
+					Controls: []layer2.Control{
+						{
+							Id: "AC-1",
+							GuidelineMappings: []layer2.Mapping{
+								{ReferenceId: "NIST-800-53", Entries: []layer2.MappingEntry{{ReferenceId: "AC-1"}}},
+								{ReferenceId: "ISO-27001", Entries: []layer2.MappingEntry{{ReferenceId: "A.9.1.1"}}},
+							},
+						},
+						{
+							Id: "SC-1",
+							GuidelineMappings: []layer2.Mapping{
+								{ReferenceId: "NIST-800-53", Entries: []layer2.MappingEntry{{ReferenceId: "SC-1"}}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	service := NewService(mapper.Set{"test-policy-engine": mapperPlugin}, scope)
+
+	evidence := func(ruleID string, status api.EvidencePolicyEvaluationStatus) api.Evidence {
+		return api.Evidence{
+			PolicyEngineName:       "test-policy-engine",
+			PolicyRuleId:           ruleID,
+			PolicyEvaluationStatus: status,
+			Timestamp:              time.Now(),
+		}
+	}
+	body, err := json.Marshal(api.SummaryRequest{
+		Evidence: []api.Evidence{
+			evidence("rule-1", api.EvidencePolicyEvaluationStatusPassed),
+			evidence("rule-2", api.EvidencePolicyEvaluationStatusFailed),
+			evidence("rule-3", api.EvidencePolicyEvaluationStatusPassed),
+			evidence("rule-3", api.EvidencePolicyEvaluationStatusFailed),
+			evidence("rule-unknown", api.EvidencePolicyEvaluationStatusPassed),
+		},
+	})
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/v1/summary", bytes.NewReader(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	service.PostV1Summary(c)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var response api.SummaryResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+	assert.Equal(t, 1, response.Unmapped)
+	assert.Equal(t, []api.FrameworkSummary{
+		{
+			Framework: "ISO-27001",
+			Counts: map[string]int{
+				string(api.ComplianceStatusCompliant):    1,
+				string(api.ComplianceStatusNonCompliant): 1,
+			},
+			Total: 2,
+		},
+		{
+			Framework: "NIST-800-53",
+			Counts: map[string]int{
+				string(api.ComplianceStatusCompliant):    2,
+				string(api.ComplianceStatusNonCompliant): 2,
+			},
+			Total: 4,
+		},
+	}, response.Frameworks)
+}
+
+func TestPostV1SummaryUnknownEngine(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	service := NewService(make(mapper.Set), make(mapper.Scope), WithStrictEngines())
+	body, err := json.Marshal(api.SummaryRequest{
+		Evidence: []api.Evidence{
+			{
+				PolicyEngineName:       "unknown-engine",
+				PolicyRuleId:           "rule-1",
+				PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
+				Timestamp:              time.Now(),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/v1/summary", bytes.NewReader(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	service.PostV1Summary(c)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	var apiErr api.Error
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &apiErr))
+	require.NotNil(t, apiErr.Reason)
+	assert.Equal(t, ReasonEngineNotFound, *apiErr.Reason)
+}
+
+type statusMapper struct {
+	id         mapper.ID
+	status     api.ComplianceEnrichmentStatus
+	frameworks []string
+	calls      int
+}
+
+func (m *statusMapper) PluginName() mapper.ID { return m.id }
+
+func (m *statusMapper) Map(_ api.Evidence, _ mapper.Scope) (api.Compliance, error) {
+	m.calls++
+	return api.Compliance{
+		Control:          api.ComplianceControl{Id: string(m.id)},
+		Status:           api.ComplianceStatusCompliant,
+		EnrichmentStatus: m.status,
+		Frameworks:       api.ComplianceFrameworks{Frameworks: m.frameworks},
+	}, nil
+}
+
+func (m *statusMapper) AddEvaluationPlan(_ string, _ ...layer4.AssessmentPlan) {}
+
+func TestPostV1EnrichFallbackChain(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	vendor := &statusMapper{id: "vendor", status: api.ComplianceEnrichmentStatusUnmapped}
+	generic := &statusMapper{id: "generic", status: api.ComplianceEnrichmentStatusSuccess}
+	last := &statusMapper{id: "last", status: api.ComplianceEnrichmentStatusSuccess}
+	service := NewService(
+		mapper.Set{"test-policy-engine": vendor},
+		make(mapper.Scope),
+		WithFallbackMappers(generic, last),
+	)
+
+	body, err := json.Marshal(api.EnrichmentRequest{
+		Evidence: api.Evidence{
+			PolicyEngineName:       "test-policy-engine",
+			PolicyRuleId:           "AC-1",
+			PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
+			Timestamp:              time.Now(),
+		},
+	})
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/v1/enrich", bytes.NewReader(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	service.PostV1Enrich(c)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var response api.EnrichmentResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, api.ComplianceEnrichmentStatusSuccess, response.Compliance.EnrichmentStatus)
+	assert.Equal(t, "generic", response.Compliance.Control.Id)
+	require.NotNil(t, response.MapperId)
+	assert.Equal(t, "generic", *response.MapperId)
+	assert.Equal(t, 1, vendor.calls)
+	assert.Equal(t, 1, generic.calls)
+	assert.Equal(t, 0, last.calls, "chain should stop at the first mapped result")
+}
+
+func TestPostV1EnrichDisableFallback(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name             string
+		engine           string
+		disableFallback  *bool
+		expectedStatus   api.ComplianceEnrichmentStatus
+		expectedControl  string
+		expectedFallback int
+	}{
+		{
+			name:             "Fallback enabled by default",
+			engine:           "test-policy-engine",
+			expectedStatus:   api.ComplianceEnrichmentStatusSuccess,
+			expectedControl:  "generic",
+			expectedFallback: 1,
+		},
+		{
+			name:             "Fallback explicitly enabled",
+			engine:           "test-policy-engine",
+			disableFallback:  boolPtr(false),
+			expectedStatus:   api.ComplianceEnrichmentStatusSuccess,
+			expectedControl:  "generic",
+			expectedFallback: 1,
+		},
+		{
+			name:            "Fallback disabled returns the engine mapper result",
+			engine:          "test-policy-engine",
+			disableFallback: boolPtr(true),
+			expectedStatus:  api.ComplianceEnrichmentStatusUnmapped,
+			expectedControl: "vendor",
+		},
+		{
+			name:            "Fallback disabled for an unknown engine reports unmapped",
+			engine:          "unknown-engine",
+			disableFallback: boolPtr(true),
+			expectedStatus:  api.ComplianceEnrichmentStatusUnmapped,
+			expectedControl: "UNMAPPED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vendor := &statusMapper{id: "vendor", status: api.ComplianceEnrichmentStatusUnmapped}
+			generic := &statusMapper{id: "generic", status: api.ComplianceEnrichmentStatusSuccess}
+			service := NewService(
+				mapper.Set{"test-policy-engine": vendor},
+				make(mapper.Scope),
+				WithFallbackMappers(generic),
+			)
+
+			body, err := json.Marshal(api.EnrichmentRequest{
+				Evidence: api.Evidence{
+					PolicyEngineName:       tt.engine,
+					PolicyRuleId:           "AC-1",
+					PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
+					Timestamp:              time.Now(),
+				},
+				DisableFallback: tt.disableFallback,
+			})
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/v1/enrich", bytes.NewReader(body))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			service.PostV1Enrich(c)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			var response api.EnrichmentResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tt.expectedStatus, response.Compliance.EnrichmentStatus)
+			assert.Equal(t, tt.expectedControl, response.Compliance.Control.Id)
+			assert.Equal(t, tt.expectedFallback, generic.calls)
+		})
+	}
+}
+
+func TestPostV1SummaryDisableFallback(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name             string
+		disableFallback  *bool
+		expectedUnmapped int
+	}{
+		{
+			name:             "Fallback enabled",
+			expectedUnmapped: 0,
+		},
+		{
+			name:             "Fallback disabled",
+			disableFallback:  boolPtr(true),
+			expectedUnmapped: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fallback := &statusMapper{
+				id:         "generic",
+				status:     api.ComplianceEnrichmentStatusSuccess,
+				frameworks: []string{"NIST-800-53"},
+			}
+			service := NewService(make(mapper.Set), make(mapper.Scope), WithFallbackMappers(fallback))
+
+			body, err := json.Marshal(api.SummaryRequest{
+				Evidence: []api.Evidence{
+					{
+						PolicyEngineName:       "unknown-engine",
+						PolicyRuleId:           "rule-1",
+						PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
+						Timestamp:              time.Now(),
+					},
+				},
+				DisableFallback: tt.disableFallback,
+			})
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/v1/summary", bytes.NewReader(body))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			service.PostV1Summary(c)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			var response api.SummaryResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tt.expectedUnmapped, response.Unmapped)
+			assert.Len(t, response.Frameworks, 1-tt.expectedUnmapped)
+		})
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestEnrichWithChain(t *testing.T) {
+	evidence := api.Evidence{
+		PolicyEngineName:       "test-policy-engine",
+		PolicyRuleId:           "AC-1",
+		PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
+		Timestamp:              time.Now(),
+	}
+
+	t.Run("All mappers unmapped returns last result", func(t *testing.T) {
+		chain := []mapper.Mapper{
+			&statusMapper{id: "first", status: api.ComplianceEnrichmentStatusUnmapped},
+			&statusMapper{id: "second", status: api.ComplianceEnrichmentStatusUnmapped},
+		}
+		response, id, err := enrichWithChain(evidence, chain, make(mapper.Scope))
+		require.NoError(t, err)
+		assert.Equal(t, mapper.ID("second"), id)
+		assert.Equal(t, api.ComplianceEnrichmentStatusUnmapped, response.Compliance.EnrichmentStatus)
+	})
+
+	t.Run("Mapper failure stops the chain", func(t *testing.T) {
+		next := &statusMapper{id: "next", status: api.ComplianceEnrichmentStatusSuccess}
+		chain := []mapper.Mapper{&failingMapper{err: errors.New("plan lookup failed")}, next}
+		_, id, err := enrichWithChain(evidence, chain, make(mapper.Scope))
+		require.Error(t, err)
+		assert.Equal(t, mapper.ID("failing"), id)
+		assert.Equal(t, 0, next.calls)
+	})
+
+	t.Run("Mapper panic stops the chain", func(t *testing.T) {
+		next := &statusMapper{id: "next", status: api.ComplianceEnrichmentStatusSuccess}
+		chain := []mapper.Mapper{&failingMapper{panic: true}, next}
+		_, id, err := enrichWithChain(evidence, chain, make(mapper.Scope))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unexpected plan layout")
+		assert.Equal(t, mapper.ID("failing"), id)
+		assert.Equal(t, 0, next.calls)
+	})
+}
+
+// failingMapper fails every mapping, either by returning err or by panicking.
+type failingMapper struct {
+	err   error
+	panic bool
+}
+
+func (m *failingMapper) PluginName() mapper.ID { return "failing" }
+
+func (m *failingMapper) Map(_ api.Evidence, _ mapper.Scope) (api.Compliance, error) {
+	if m.panic {
+		panic("unexpected plan layout")
+	}
+	return api.Compliance{}, m.err
+}
+
+func (m *failingMapper) AddEvaluationPlan(_ string, _ ...layer4.AssessmentPlan) {}
+
+func TestEnrichMapperFailure(t *testing.T) {
+	evidence := api.Evidence{
+		PolicyEngineName:       "test-policy-engine",
+		PolicyRuleId:           "AC-1",
+		PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
+		Timestamp:              time.Now(),
+	}
+	mappingErr := errors.New("plan lookup failed")
+
+	t.Run("Mapper error is returned", func(t *testing.T) {
+		_, err := enrich(evidence, &failingMapper{err: mappingErr}, make(mapper.Scope))
+		assert.ErrorIs(t, err, mappingErr)
+	})
+
+	t.Run("Mapper panic is recovered", func(t *testing.T) {
+		_, err := enrich(evidence, &failingMapper{panic: true}, make(mapper.Scope))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unexpected plan layout")
+	})
+
+	t.Run("Handler responds with error", func(t *testing.T) {
+		gin.SetMode(gin.TestMode)
+		service := NewService(mapper.Set{"test-policy-engine": &failingMapper{panic: true}}, make(mapper.Scope))
+
+		body, err := json.Marshal(api.EnrichmentRequest{Evidence: evidence})
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/v1/enrich", bytes.NewReader(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		service.PostV1Enrich(c)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		var apiErr api.Error
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &apiErr))
+		assert.Equal(t, int32(http.StatusInternalServerError), apiErr.Code)
+		assert.Equal(t, "Failed to enrich evidence", apiErr.Message)
+		require.NotNil(t, apiErr.Reason)
+		assert.Equal(t, ReasonEnrichmentFailed, *apiErr.Reason)
+	})
+}
+
+// countingMapper records how many times Map is invoked.
+type countingMapper struct {
+	calls int
+}
+
+func (m *countingMapper) PluginName() mapper.ID { return "counting" }
+
+func (m *countingMapper) Map(_ api.Evidence, _ mapper.Scope) (api.Compliance, error) {
+	m.calls++
+	return api.Compliance{
+		Status:           api.ComplianceStatusCompliant,
+		EnrichmentStatus: api.ComplianceEnrichmentStatusSuccess,
+	}, nil
+}
+
+func (m *countingMapper) AddEvaluationPlan(_ string, _ ...layer4.AssessmentPlan) {}
+
+// scopeMapper records the catalog IDs in the scope it maps against.
+type scopeMapper struct {
+	catalogs []string
+}
+
+func (m *scopeMapper) PluginName() mapper.ID { return "scope" }
+
+func (m *scopeMapper) Map(_ api.Evidence, scope mapper.Scope) (api.Compliance, error) {
+	m.catalogs = slices.Sorted(maps.Keys(scope))
+	return api.Compliance{
+		Status:           api.ComplianceStatusCompliant,
+		EnrichmentStatus: api.ComplianceEnrichmentStatusSuccess,
+	}, nil
+}
+
+func (m *scopeMapper) AddEvaluationPlan(_ string, _ ...layer4.AssessmentPlan) {}
+
+func TestPostV1EnrichCatalogSelector(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name             string
+		catalogs         *[]string
+		expectedCode     int
+		expectedCatalogs []string
+	}{
+		{
+			name:             "No selector uses every catalog",
+			expectedCode:     http.StatusOK,
+			expectedCatalogs: []string{"tenant-a", "tenant-b", "tenant-c"},
+		},
+		{
+			name:             "Empty selector uses every catalog",
+			catalogs:         &[]string{},
+			expectedCode:     http.StatusOK,
+			expectedCatalogs: []string{"tenant-a", "tenant-b", "tenant-c"},
+		},
+		{
+			name:             "Selector restricts the scope",
+			catalogs:         &[]string{"tenant-c", "tenant-a"},
+			expectedCode:     http.StatusOK,
+			expectedCatalogs: []string{"tenant-a", "tenant-c"},
+		},
+		{
+			name:         "Unknown catalog is rejected",
+			catalogs:     &[]string{"tenant-a", "tenant-z"},
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scoped := &scopeMapper{}
+			scope := mapper.Scope{
+				"tenant-a": layer2.Catalog{Metadata: layer2.Metadata{Id: "tenant-a"}},
+				"tenant-b": layer2.Catalog{Metadata: layer2.Metadata{Id: "tenant-b"}},
+				"tenant-c": layer2.Catalog{Metadata: layer2.Metadata{Id: "tenant-c"}},
+			}
+			service := NewService(mapper.Set{"test-policy-engine": scoped}, scope)
+
+			body, err := json.Marshal(api.EnrichmentRequest{
+				Evidence: api.Evidence{
+					PolicyEngineName:       "test-policy-engine",
+					PolicyRuleId:           "AC-1",
+					PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
+					Timestamp:              time.Now(),
+				},
+				Catalogs: tt.catalogs,
+			})
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/v1/enrich", bytes.NewReader(body))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			service.PostV1Enrich(c)
+
+			require.Equal(t, tt.expectedCode, w.Code)
+			if tt.expectedCode == http.StatusNotFound {
+				var apiErr api.Error
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &apiErr))
+				assert.Contains(t, apiErr.Message, "tenant-z")
+				require.NotNil(t, apiErr.Reason)
+				assert.Equal(t, ReasonCatalogNotFound, *apiErr.Reason)
+				assert.Nil(t, scoped.catalogs, "mapper should not run for an unknown catalog")
+				return
+			}
+			assert.Equal(t, tt.expectedCatalogs, scoped.catalogs)
+		})
+	}
+}
+
+func TestPostV1EnrichCancellation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name          string
+		cancel        bool
+		expectedCalls int
+		expectedCode  int
+	}{
+		{
+			name:          "Active request is mapped",
+			cancel:        false,
+			expectedCalls: 1,
+			expectedCode:  http.StatusOK,
+		},
+		{
+			name:          "Cancelled request skips mapping",
+			cancel:        true,
+			expectedCalls: 0,
+			expectedCode:  http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapperPlugin := &countingMapper{}
+			service := NewService(mapper.Set{"test-policy-engine": mapperPlugin}, make(mapper.Scope))
+
+			body, err := json.Marshal(api.EnrichmentRequest{
+				Evidence: api.Evidence{
+					PolicyEngineName:       "test-policy-engine",
+					PolicyRuleId:           "AC-1",
+					PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
+					Timestamp:              time.Now(),
+				},
+			})
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancel {
+				cancel()
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/v1/enrich", bytes.NewReader(body)).WithContext(ctx)
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			service.PostV1Enrich(c)
+
+			assert.Equal(t, tt.expectedCalls, mapperPlugin.calls)
+			assert.Equal(t, tt.expectedCode, w.Code)
+			if tt.cancel {
+				assert.True(t, c.IsAborted())
+				assert.Zero(t, w.Body.Len())
+			}
+		})
+	}
+}
+
+func TestPostV1SummaryCatalogSelector(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name             string
+		catalogs         *[]string
+		expectedCode     int
+		expectedCatalogs []string
+	}{
+		{
+			name:             "No selector uses every catalog",
+			expectedCode:     http.StatusOK,
+			expectedCatalogs: []string{"tenant-a", "tenant-b"},
+		},
+		{
+			name:             "Selector restricts the scope",
+			catalogs:         &[]string{"tenant-b"},
+			expectedCode:     http.StatusOK,
+			expectedCatalogs: []string{"tenant-b"},
+		},
+		{
+			name:         "Unknown catalog is rejected",
+			catalogs:     &[]string{"tenant-z"},
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scoped := &scopeMapper{}
+			scope := mapper.Scope{
+				"tenant-a": layer2.Catalog{Metadata: layer2.Metadata{Id: "tenant-a"}},
+				"tenant-b": layer2.Catalog{Metadata: layer2.Metadata{Id: "tenant-b"}},
+			}
+			service := NewService(mapper.Set{"test-policy-engine": scoped}, scope)
+
+			body, err := json.Marshal(api.SummaryRequest{
+				Evidence: []api.Evidence{
+					{
+						PolicyEngineName:       "test-policy-engine",
+						PolicyRuleId:           "AC-1",
+						PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
+						Timestamp:              time.Now(),
+					},
+				},
+				Catalogs: tt.catalogs,
+			})
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/v1/summary", bytes.NewReader(body))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			service.PostV1Summary(c)
+
+			require.Equal(t, tt.expectedCode, w.Code)
+			if tt.expectedCode == http.StatusNotFound {
+				var apiErr api.Error
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &apiErr))
+				require.NotNil(t, apiErr.Reason)
+				assert.Equal(t, ReasonCatalogNotFound, *apiErr.Reason)
+				assert.Nil(t, scoped.catalogs, "mapper should not run for an unknown catalog")
+				return
+			}
+			assert.Equal(t, tt.expectedCatalogs, scoped.catalogs)
+		})
+	}
+}
+
+func TestPostV1SummaryCancellation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mapperPlugin := &countingMapper{}
+	service := NewService(mapper.Set{"test-policy-engine": mapperPlugin}, make(mapper.Scope))
+
+	body, err := json.Marshal(api.SummaryRequest{
+		Evidence: []api.Evidence{
+			{
+				PolicyEngineName:       "test-policy-engine",
+				PolicyRuleId:           "AC-1",
+				PolicyEvaluationStatus: api.EvidencePolicyEvaluationStatusPassed,
+				Timestamp:              time.Now(),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/v1/summary", bytes.NewReader(body)).WithContext(ctx)
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	service.PostV1Summary(c)
+
+	assert.Zero(t, mapperPlugin.calls)
+	assert.True(t, c.IsAborted())
+	assert.Zero(t, w.Body.Len())
+}
+
 // validateEnrichmentResponse validates an EnrichmentResponse against the OpenAPI schema
 func validateEnrichmentResponse(t *testing.T, response api.EnrichmentResponse, swagger *openapi3.T) error {
 	t.Helper()
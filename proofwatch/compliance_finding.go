@@ -0,0 +1,121 @@
+package proofwatch
+
+import (
+	"fmt"
+
+	ocsf "github.com/Santiago-Labs/go-ocsf/ocsf/v1_5_0"
+)
+
+// OCSF Compliance Finding class identifiers.
+// See https://schema.ocsf.io/1.5.0/classes/compliance_finding
+const (
+	complianceFindingCategoryUID  int32 = 2
+	complianceFindingClassUID     int32 = 2003
+	complianceFindingActivityID   int32 = 1 // Create
+	complianceFindingCategoryName       = "Findings"
+	complianceFindingClassName          = "Compliance Finding"
+	complianceFindingActivityName       = "Create"
+)
+
+// ComplianceContext holds the compliance attributes added to evidence by
+// the `compass` service during pipeline enrichment.
+type ComplianceContext struct {
+	ControlID              string
+	Category               string
+	Frameworks             []string
+	Requirements           []string
+	Status                 string
+	RiskLevel              string
+	RemediationDescription string
+}
+
+// NewComplianceFinding converts enriched OCSF evidence and its compliance context into
+// an OCSF Compliance Finding.
+func NewComplianceFinding(evidence OCSFEvidence, compliance ComplianceContext) ocsf.ComplianceFinding {
+	policyID := stringVal(evidence.Policy.Uid, "unknown_policy_id")
+	statusID, status := mapComplianceStatusID(compliance.Status)
+	severityID, severity := mapRiskLevelSeverityID(compliance.RiskLevel)
+
+	finding := ocsf.ComplianceFinding{
+		ActivityId:   complianceFindingActivityID,
+		ActivityName: ptr(complianceFindingActivityName),
+		CategoryUid:  complianceFindingCategoryUID,
+		CategoryName: ptr(complianceFindingCategoryName),
+		ClassUid:     complianceFindingClassUID,
+		ClassName:    ptr(complianceFindingClassName),
+		TypeUid:      int64(complianceFindingClassUID)*100 + int64(complianceFindingActivityID),
+		TypeName:     ptr(fmt.Sprintf("%s: %s", complianceFindingClassName, complianceFindingActivityName)),
+		Time:         evidence.Time,
+		Metadata:     evidence.Metadata,
+		Message:      evidence.Message,
+		SeverityId:   severityID,
+		Severity:     ptr(severity),
+		Osint:        []ocsf.OSINT{},
+		FindingInfo: ocsf.FindingInformation{
+			Uid:   fmt.Sprintf("%s:%s", policyID, compliance.ControlID),
+			Title: evidence.Policy.Name,
+			Desc:  evidence.Policy.Desc,
+		},
+		Compliance: ocsf.Compliance{
+			Control:      optionalString(compliance.ControlID),
+			Category:     optionalString(compliance.Category),
+			Standards:    compliance.Frameworks,
+			Requirements: compliance.Requirements,
+			StatusId:     &statusID,
+			Status:       ptr(status),
+		},
+	}
+
+	if compliance.RemediationDescription != "" {
+		finding.Remediation = &ocsf.Remediation{Desc: compliance.RemediationDescription}
+	}
+
+	return finding
+}
+
+// mapComplianceStatusID maps a `compliance.status` value to the OCSF compliance status_id and caption.
+// Statuses without an OCSF equivalent are reported as Other with the original value as the caption.
+func mapComplianceStatusID(status string) (int32, string) {
+	switch status {
+	case "Compliant":
+		return 1, "Pass"
+	case "Needs Review":
+		return 2, "Warning"
+	case "Non-Compliant":
+		return 3, "Fail"
+	case "Exempt", "Not Applicable":
+		return 99, status
+	default:
+		return 0, "Unknown"
+	}
+}
+
+// mapRiskLevelSeverityID maps a `compliance.risk.level` value to the OCSF severity_id and caption.
+func mapRiskLevelSeverityID(riskLevel string) (int32, string) {
+	switch riskLevel {
+	case "Informational":
+		return 1, "Informational"
+	case "Low":
+		return 2, "Low"
+	case "Medium":
+		return 3, "Medium"
+	case "High":
+		return 4, "High"
+	case "Critical":
+		return 5, "Critical"
+	default:
+		return 0, "Unknown"
+	}
+}
+
+// optionalString returns nil for empty strings so they are omitted from the finding.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
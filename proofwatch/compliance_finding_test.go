@@ -0,0 +1,97 @@
+package proofwatch
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewComplianceFinding(t *testing.T) {
+	evidence := createTestEvidence()
+	evidence.Message = stringPtr("branch protection is enabled")
+	compliance := ComplianceContext{
+		ControlID:              "OSPS-AC-03",
+		Category:               "Access Control",
+		Frameworks:             []string{"NIST-800-53", "ISO-27001"},
+		Requirements:           []string{"AC-3", "A.9.4.1"},
+		Status:                 "Non-Compliant",
+		RiskLevel:              "High",
+		RemediationDescription: "Enable branch protection on the default branch",
+	}
+
+	finding := NewComplianceFinding(evidence, compliance)
+
+	data, err := json.Marshal(finding)
+	require.NoError(t, err)
+
+	var got map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &got))
+
+	assert.Equal(t, float64(2003), got["class_uid"])
+	assert.Equal(t, "Compliance Finding", got["class_name"])
+	assert.Equal(t, float64(2), got["category_uid"])
+	assert.Equal(t, "Findings", got["category_name"])
+	assert.Equal(t, float64(1), got["activity_id"])
+	assert.Equal(t, float64(200301), got["type_uid"])
+	assert.Equal(t, "Compliance Finding: Create", got["type_name"])
+	assert.Equal(t, float64(evidence.Time), got["time"])
+	assert.Equal(t, "branch protection is enabled", got["message"])
+	assert.Equal(t, float64(4), got["severity_id"])
+	assert.Equal(t, "High", got["severity"])
+
+	metadata := got["metadata"].(map[string]interface{})
+	assert.Equal(t, "test-product", metadata["product"].(map[string]interface{})["name"])
+
+	findingInfo := got["finding_info"].(map[string]interface{})
+	assert.Equal(t, "test-policy:OSPS-AC-03", findingInfo["uid"])
+	assert.Equal(t, "test-policy", findingInfo["title"])
+
+	complianceObj := got["compliance"].(map[string]interface{})
+	assert.Equal(t, "OSPS-AC-03", complianceObj["control"])
+	assert.Equal(t, "Access Control", complianceObj["category"])
+	assert.Equal(t, []interface{}{"NIST-800-53", "ISO-27001"}, complianceObj["standards"])
+	assert.Equal(t, []interface{}{"AC-3", "A.9.4.1"}, complianceObj["requirements"])
+	assert.Equal(t, float64(3), complianceObj["status_id"])
+	assert.Equal(t, "Fail", complianceObj["status"])
+
+	remediation := got["remediation"].(map[string]interface{})
+	assert.Equal(t, "Enable branch protection on the default branch", remediation["desc"])
+}
+
+func TestNewComplianceFindingMinimal(t *testing.T) {
+	finding := NewComplianceFinding(OCSFEvidence{}, ComplianceContext{})
+
+	assert.Equal(t, "unknown_policy_id:", finding.FindingInfo.Uid)
+	assert.Nil(t, finding.Compliance.Control)
+	assert.Nil(t, finding.Compliance.Category)
+	assert.Nil(t, finding.Remediation)
+	assert.Equal(t, int32(0), finding.SeverityId)
+	require.NotNil(t, finding.Compliance.StatusId)
+	assert.Equal(t, int32(0), *finding.Compliance.StatusId)
+}
+
+func TestMapComplianceStatusID(t *testing.T) {
+	tests := []struct {
+		status          string
+		expectedID      int32
+		expectedCaption string
+	}{
+		{status: "Compliant", expectedID: 1, expectedCaption: "Pass"},
+		{status: "Needs Review", expectedID: 2, expectedCaption: "Warning"},
+		{status: "Non-Compliant", expectedID: 3, expectedCaption: "Fail"},
+		{status: "Exempt", expectedID: 99, expectedCaption: "Exempt"},
+		{status: "Not Applicable", expectedID: 99, expectedCaption: "Not Applicable"},
+		{status: "Unknown", expectedID: 0, expectedCaption: "Unknown"},
+		{status: "", expectedID: 0, expectedCaption: "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			id, caption := mapComplianceStatusID(tt.status)
+			assert.Equal(t, tt.expectedID, id)
+			assert.Equal(t, tt.expectedCaption, caption)
+		})
+	}
+}
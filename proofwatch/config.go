@@ -7,9 +7,21 @@ import (
 )
 
 type config struct {
-	LoggerProvider log.LoggerProvider
-	MeterProvider  metric.MeterProvider
-	TracerProvider trace.TracerProvider
+	LoggerProvider   log.LoggerProvider
+	MeterProvider    metric.MeterProvider
+	TracerProvider   trace.TracerProvider
+	StrictTimestamps bool
+	RateLimit        float64
+	Name             string
+	Sampling         *samplingConfig
+	SchemaValidation bool
+	DefaultResult    string
+	StrictStatus     bool
+}
+
+type samplingConfig struct {
+	Rate               float64
+	AlwaysKeepFailures bool
 }
 
 type OptionFunc func(*config)
@@ -43,3 +55,67 @@ func WithTracerProvider(provider trace.TracerProvider) OptionFunc {
 		}
 	})
 }
+
+// WithStrictTimestamps rejects evidence without a valid timestamp instead of
+// logging it with the current time.
+func WithStrictTimestamps() OptionFunc {
+	return OptionFunc(func(cfg *config) {
+		cfg.StrictTimestamps = true
+	})
+}
+
+// WithRateLimit paces log emission to at most perSecond evidence records per second.
+// If none is specified, or perSecond is not positive, emission is unlimited.
+func WithRateLimit(perSecond float64) OptionFunc {
+	return OptionFunc(func(cfg *config) {
+		cfg.RateLimit = perSecond
+	})
+}
+
+// WithName sets the name of this ProofWatch instance, emitted as the
+// evidence.collector.name attribute on every record so evidence can be traced
+// to the collector or pipeline stage that emitted it.
+func WithName(name string) OptionFunc {
+	return OptionFunc(func(cfg *config) {
+		cfg.Name = name
+	})
+}
+
+// WithSampling emits only a share of the evidence records, given by rate
+// between 0 and 1, to reduce the volume from busy policy engines. When
+// alwaysKeepFailures is set, failed evaluations and blocked actions are always
+// emitted and only the remaining records are sampled.
+// If none is specified, every record is emitted.
+func WithSampling(rate float64, alwaysKeepFailures bool) OptionFunc {
+	return OptionFunc(func(cfg *config) {
+		cfg.Sampling = &samplingConfig{Rate: rate, AlwaysKeepFailures: alwaysKeepFailures}
+	})
+}
+
+// WithDefaultResult sets the evaluation result, such as "Not Run", reported for
+// OCSF evidence without a status. If none is specified, such evidence is
+// reported as "Unknown".
+func WithDefaultResult(result string) OptionFunc {
+	return OptionFunc(func(cfg *config) {
+		cfg.DefaultResult = result
+	})
+}
+
+// WithStrictStatus rejects OCSF evidence without a status with
+// ErrMissingStatus instead of logging it with the default result.
+func WithStrictStatus() OptionFunc {
+	return OptionFunc(func(cfg *config) {
+		cfg.StrictStatus = true
+	})
+}
+
+// WithSchemaValidation validates evidence received by a Receiver or ingested
+// from NDJSON against the JSON schema for its kind before decoding it, and
+// rejects payloads that do not match. Use it when evidence comes from
+// untrusted sources. OCSF and Gemara evidence have schemas; other kinds are
+// decoded without validation.
+func WithSchemaValidation() OptionFunc {
+	return OptionFunc(func(cfg *config) {
+		cfg.SchemaValidation = true
+	})
+}
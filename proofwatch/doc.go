@@ -25,6 +25,12 @@
 //		proofwatch.WithTracerProvider(customTracerProvider),
 //	)
 //
+// Receiving Evidence:
+//
+//	// Accept serialized evidence over HTTP at POST /v1/evidence/{kind}
+//	receiver := proofwatch.NewReceiver(pw, proofwatch.DefaultRegistry())
+//	err = http.ListenAndServe(":8088", receiver)
+//
 // Metrics:
 //   - evidence_processed_count: Total number of evidence items processed successfully
 //   - evidence_dropped_count: Total number of evidence items dropped due to failures
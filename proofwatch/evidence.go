@@ -1,6 +1,8 @@
 package proofwatch
 
 import (
+	"cmp"
+	"slices"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -16,6 +18,38 @@ type Evidence interface {
 	// for observability, monitoring, and compliance tracking purposes
 	Attributes() []attribute.KeyValue
 
-	// Timestamp returns the time when the evidence was generated or collected
+	// Timestamp returns the time when the evidence was generated or collected,
+	// or the zero time if the evidence has no valid timestamp.
 	Timestamp() time.Time
 }
+
+// evidenceSplitter is implemented by evidence that records several policy
+// evaluations at once.
+type evidenceSplitter interface {
+	// split returns one Evidence per evaluation, or nil when the evidence
+	// records a single evaluation.
+	split() []Evidence
+}
+
+// SplitEvidence returns one Evidence per policy evaluation recorded by
+// evidence, such as a scan that evaluated several policies. Evidence
+// recording a single evaluation is returned as is.
+func SplitEvidence(evidence Evidence) []Evidence {
+	if splitter, ok := evidence.(evidenceSplitter); ok {
+		if split := splitter.split(); len(split) > 0 {
+			return split
+		}
+	}
+	return []Evidence{evidence}
+}
+
+// SortedAttributes returns the attributes of evidence sorted by key, so
+// exports and snapshot tests see the same order on every run. Attributes that
+// share a key keep the order in which the evidence emitted them.
+func SortedAttributes(evidence Evidence) []attribute.KeyValue {
+	attrs := slices.Clone(evidence.Attributes())
+	slices.SortStableFunc(attrs, func(a, b attribute.KeyValue) int {
+		return cmp.Compare(a.Key, b.Key)
+	})
+	return attrs
+}
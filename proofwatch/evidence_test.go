@@ -0,0 +1,105 @@
+package proofwatch
+
+import (
+	"testing"
+	"time"
+
+	ocsf "github.com/Santiago-Labs/go-ocsf/ocsf/v1_5_0"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestSortedAttributes(t *testing.T) {
+	tests := []struct {
+		name     string
+		evidence Evidence
+	}{
+		{name: "ocsf evidence", evidence: createTestEvidence()},
+		{name: "gemara evidence", evidence: createTestGemaraEvidence()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := SortedAttributes(tt.evidence)
+
+			assert.ElementsMatch(t, tt.evidence.Attributes(), attrs)
+			assert.True(t, isSortedByKey(attrs), "attributes should be sorted by key: %v", attributeKeys(attrs))
+			assert.Equal(t, attrs, SortedAttributes(tt.evidence), "order should be deterministic")
+		})
+	}
+}
+
+func TestSortedAttributesDoesNotModifyEvidence(t *testing.T) {
+	evidence := &staticEvidence{attrs: []attribute.KeyValue{
+		attribute.String(POLICY_RULE_ID, "rule"),
+		attribute.String(COMPLIANCE_STATUS, "Compliant"),
+		attribute.String(POLICY_ENGINE_NAME, "opa"),
+	}}
+
+	attrs := SortedAttributes(evidence)
+
+	assert.Equal(t, []string{COMPLIANCE_STATUS, POLICY_ENGINE_NAME, POLICY_RULE_ID}, attributeKeys(attrs))
+	assert.Equal(t, []string{POLICY_RULE_ID, COMPLIANCE_STATUS, POLICY_ENGINE_NAME}, attributeKeys(evidence.attrs))
+}
+
+func TestSplitEvidence(t *testing.T) {
+	secondPolicy := "second-policy"
+	scan := createTestEvidence()
+	scan.Policies = []ocsf.Policy{{Uid: &secondPolicy, Name: &secondPolicy}}
+
+	tests := []struct {
+		name     string
+		evidence Evidence
+		ruleIDs  []string
+	}{
+		{name: "single policy", evidence: createTestEvidence(), ruleIDs: []string{"test-policy"}},
+		{name: "scan with two policies", evidence: scan, ruleIDs: []string{"test-policy", "second-policy"}},
+		{name: "gemara evidence", evidence: createTestGemaraEvidence(), ruleIDs: []string{"test-procedure-id"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			split := SplitEvidence(tt.evidence)
+
+			var ruleIDs []string
+			for _, e := range split {
+				ruleIDs = append(ruleIDs, attributeValue(e.Attributes(), POLICY_RULE_ID))
+			}
+			assert.Equal(t, tt.ruleIDs, ruleIDs)
+		})
+	}
+}
+
+// staticEvidence returns a fixed attribute slice without copying it.
+type staticEvidence struct {
+	attrs []attribute.KeyValue
+}
+
+func (e *staticEvidence) ToJSON() ([]byte, error) {
+	return []byte("{}"), nil
+}
+
+func (e *staticEvidence) Attributes() []attribute.KeyValue {
+	return e.attrs
+}
+
+func (e *staticEvidence) Timestamp() time.Time {
+	return time.Time{}
+}
+
+func isSortedByKey(attrs []attribute.KeyValue) bool {
+	for i := 1; i < len(attrs); i++ {
+		if attrs[i-1].Key > attrs[i].Key {
+			return false
+		}
+	}
+	return true
+}
+
+func attributeKeys(attrs []attribute.KeyValue) []string {
+	keys := make([]string, len(attrs))
+	for i, attr := range attrs {
+		keys[i] = string(attr.Key)
+	}
+	return keys
+}
@@ -0,0 +1,152 @@
+package proofwatch
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// csvHeader defines the columns written by ExportCSV.
+var csvHeader = []string{"policy_id", "source", "subject_name", "decision", "timestamp"}
+
+// ExportCSV writes a flat CSV of evaluated policies and their results to w,
+// one row per policy evaluation after a header row. Fields containing commas,
+// quotes, or newlines are quoted. Evidence without a valid timestamp has an
+// empty timestamp field.
+func ExportCSV(w io.Writer, evidence []Evidence) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	var evaluations []Evidence
+	for _, e := range evidence {
+		evaluations = append(evaluations, SplitEvidence(e)...)
+	}
+	for _, e := range evaluations {
+		attrs := attributeLookup(e.Attributes())
+
+		// Prefer the human-readable target name, falling back to the ID.
+		subject := attrs[POLICY_TARGET_NAME]
+		if subject == "" {
+			subject = attrs[POLICY_TARGET_ID]
+		}
+
+		var timestamp string
+		if ts := e.Timestamp(); !ts.IsZero() {
+			timestamp = ts.UTC().Format(time.RFC3339)
+		}
+
+		row := []string{
+			attrs[POLICY_RULE_ID],
+			attrs[POLICY_ENGINE_NAME],
+			subject,
+			attrs[POLICY_EVALUATION_RESULT],
+			timestamp,
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// attributeLookup indexes attributes by key using their string representation.
+func attributeLookup(attrs []attribute.KeyValue) map[string]string {
+	lookup := make(map[string]string, len(attrs))
+	for _, attr := range attrs {
+		lookup[string(attr.Key)] = attr.Value.Emit()
+	}
+	return lookup
+}
+
+// attributeValue returns the value of key in attrs, or the empty string when
+// it is not present. Like attributeLookup, the last value of a repeated key
+// wins.
+func attributeValue(attrs []attribute.KeyValue, key string) string {
+	for i := len(attrs) - 1; i >= 0; i-- {
+		if string(attrs[i].Key) == key {
+			return attrs[i].Value.Emit()
+		}
+	}
+	return ""
+}
+
+// ExportJSONLines writes each evidence item to w as a single line of JSON.
+func ExportJSONLines(w io.Writer, evidence []Evidence) error {
+	writer := NewJSONLinesWriter(w)
+	for _, e := range evidence {
+		if err := writer.Write(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JSONLinesWriter streams evidence to an io.Writer in JSON Lines format,
+// so large sets of evidence can be exported without buffering them in memory.
+// Each line is flushed to the underlying writer as it is written. Once a write
+// fails, the first error is retained and returned by all subsequent calls.
+type JSONLinesWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+// NewJSONLinesWriter returns a JSONLinesWriter that writes to w.
+func NewJSONLinesWriter(w io.Writer) *JSONLinesWriter {
+	return &JSONLinesWriter{w: bufio.NewWriter(w)}
+}
+
+// Write serializes the evidence using its ToJSON method and writes it as a single line.
+func (j *JSONLinesWriter) Write(evidence Evidence) error {
+	if j.err != nil {
+		return j.err
+	}
+
+	data, err := evidence.ToJSON()
+	if err != nil {
+		j.err = err
+		return err
+	}
+
+	if _, err := j.w.Write(data); err != nil {
+		j.err = err
+		return err
+	}
+	if err := j.w.WriteByte('\n'); err != nil {
+		j.err = err
+		return err
+	}
+	if err := j.w.Flush(); err != nil {
+		j.err = err
+		return err
+	}
+	return nil
+}
+
+// Err returns the first error encountered while writing, if any.
+func (j *JSONLinesWriter) Err() error {
+	return j.err
+}
+
+// ExportParquet writes the evidence to w as a single Parquet file for analytics
+// engines such as DuckDB or Athena. Columns follow the parquet struct tags on
+// OCSFEvidence, and optional fields are written as nullable columns.
+func ExportParquet(w io.Writer, events []OCSFEvidence) error {
+	writer := parquet.NewGenericWriter[OCSFEvidence](w)
+	if _, err := writer.Write(events); err != nil {
+		_ = writer.Close()
+		return fmt.Errorf("failed to write evidence to parquet: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to close parquet writer: %w", err)
+	}
+	return nil
+}
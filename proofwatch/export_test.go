@@ -0,0 +1,170 @@
+package proofwatch
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportCSV(t *testing.T) {
+	eventTime := time.Date(2025, 1, 5, 12, 30, 0, 0, time.UTC)
+
+	evidence := createTestEvidence()
+	evidence.Time = eventTime.UnixMilli()
+	evidence.Policy.Uid = stringPtr("deny,root \"user\"")
+	evidence.Scan.Uid = stringPtr("repo\nmain")
+
+	var buf bytes.Buffer
+	err := ExportCSV(&buf, []Evidence{evidence})
+	require.NoError(t, err)
+
+	// Fields with special characters must round-trip through a CSV reader.
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	assert.Equal(t, csvHeader, records[0])
+	assert.Equal(t, []string{
+		"deny,root \"user\"",
+		"test-product",
+		"repo\nmain",
+		"Passed",
+		"2025-01-05T12:30:00Z",
+	}, records[1])
+}
+
+func TestExportCSVMissingTimestamp(t *testing.T) {
+	evidence := createTestEvidence()
+	evidence.Time = 0
+
+	var buf bytes.Buffer
+	require.NoError(t, ExportCSV(&buf, []Evidence{evidence}))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, "", records[1][4], "a missing timestamp should leave the field empty")
+}
+
+func TestExportCSVEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	err := ExportCSV(&buf, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "policy_id,source,subject_name,decision,timestamp\n", buf.String())
+}
+
+func TestExportJSONLines(t *testing.T) {
+	ocsfEvidence := createTestEvidence()
+	gemaraEvidence := createTestGemaraEvidence()
+
+	var buf bytes.Buffer
+	err := ExportJSONLines(&buf, []Evidence{ocsfEvidence, gemaraEvidence})
+	require.NoError(t, err)
+
+	scanner := bufio.NewScanner(&buf)
+
+	require.True(t, scanner.Scan())
+	var decodedOCSF OCSFEvidence
+	require.NoError(t, json.Unmarshal(scanner.Bytes(), &decodedOCSF))
+	assert.Equal(t, ocsfEvidence.Policy.Uid, decodedOCSF.Policy.Uid)
+	assert.Equal(t, ocsfEvidence.Time, decodedOCSF.Time)
+	assert.Equal(t, ocsfEvidence.Attributes(), decodedOCSF.Attributes())
+
+	require.True(t, scanner.Scan())
+	var decodedGemara GemaraEvidence
+	require.NoError(t, json.Unmarshal(scanner.Bytes(), &decodedGemara))
+	assert.Equal(t, gemaraEvidence.Attributes(), decodedGemara.Attributes())
+
+	assert.False(t, scanner.Scan(), "expected exactly two lines")
+}
+
+func TestJSONLinesWriterFlushesIncrementally(t *testing.T) {
+	var buf bytes.Buffer
+	writer := NewJSONLinesWriter(&buf)
+
+	require.NoError(t, writer.Write(createTestEvidence()))
+	firstLen := buf.Len()
+	assert.Positive(t, firstLen, "first line should be flushed before the next write")
+
+	require.NoError(t, writer.Write(createTestEvidence()))
+	assert.Greater(t, buf.Len(), firstLen)
+}
+
+// failingWriter fails every write with a fixed error.
+type failingWriter struct {
+	err   error
+	calls int
+}
+
+func (f *failingWriter) Write(_ []byte) (int, error) {
+	f.calls++
+	return 0, f.err
+}
+
+func TestJSONLinesWriterSurfacesFirstError(t *testing.T) {
+	firstErr := errors.New("disk full")
+	fw := &failingWriter{err: firstErr}
+	writer := NewJSONLinesWriter(fw)
+
+	err := writer.Write(createTestEvidence())
+	assert.ErrorIs(t, err, firstErr)
+
+	fw.err = errors.New("second failure")
+	err = writer.Write(createTestEvidence())
+	assert.ErrorIs(t, err, firstErr)
+	assert.ErrorIs(t, writer.Err(), firstErr)
+	assert.Equal(t, 1, fw.calls, "no writes should be attempted after the first failure")
+}
+
+func TestExportParquet(t *testing.T) {
+	denied := "Denied"
+	deniedID := int32(2)
+	blocked := "Blocked"
+	blockedID := int32(2)
+
+	enforced := createTestEvidence()
+	enforced.Action = &denied
+	enforced.ActionID = &deniedID
+	enforced.Disposition = &blocked
+	enforced.DispositionID = &blockedID
+
+	// No action or disposition, so the optional columns are null.
+	observed := createTestEvidence()
+	otherPolicy := "other-policy"
+	observed.Policy.Uid = &otherPolicy
+
+	var buf bytes.Buffer
+	require.NoError(t, ExportParquet(&buf, []OCSFEvidence{enforced, observed}))
+
+	rows, err := parquet.Read[OCSFEvidence](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	assert.Equal(t, *enforced.Policy.Uid, *rows[0].Policy.Uid)
+	assert.Equal(t, enforced.Time, rows[0].Time)
+	require.NotNil(t, rows[0].Action)
+	require.NotNil(t, rows[0].DispositionID)
+	assert.Equal(t, denied, *rows[0].Action)
+	assert.Equal(t, blockedID, *rows[0].DispositionID)
+
+	assert.Equal(t, otherPolicy, *rows[1].Policy.Uid)
+	assert.Nil(t, rows[1].Action)
+	assert.Nil(t, rows[1].Disposition)
+}
+
+func TestExportParquetEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, ExportParquet(&buf, nil))
+
+	rows, err := parquet.Read[OCSFEvidence](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	assert.Empty(t, rows)
+}
@@ -2,6 +2,7 @@ package proofwatch
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/ossf/gemara/layer4"
@@ -22,6 +23,29 @@ func (g GemaraEvidence) ToJSON() ([]byte, error) {
 	return json.Marshal(g)
 }
 
+// UnmarshalJSON decodes GemaraEvidence from JSON. The assessment result is
+// accepted in the string form produced by layer4.Result.MarshalJSON.
+func (g *GemaraEvidence) UnmarshalJSON(data []byte) error {
+	type evidence GemaraEvidence
+	aux := struct {
+		*evidence
+		Result string `json:"result"`
+	}{evidence: (*evidence)(g)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if aux.Result == "" {
+		return nil
+	}
+	result, err := parseResult(aux.Result)
+	if err != nil {
+		return err
+	}
+	g.Result = result
+	return nil
+}
+
 func (g GemaraEvidence) Attributes() []attribute.KeyValue {
 	attrs := []attribute.KeyValue{
 		attribute.String(POLICY_ENGINE_NAME, g.Author.Name),
@@ -46,7 +70,24 @@ func (g GemaraEvidence) Attributes() []attribute.KeyValue {
 func (g GemaraEvidence) Timestamp() time.Time {
 	timestamp, err := time.Parse(time.RFC3339, string(g.End))
 	if err != nil {
-		return time.Now()
+		return time.Time{}
 	}
 	return timestamp
 }
+
+// parseResult converts the string form of a layer4.Result back to its value.
+func parseResult(value string) (layer4.Result, error) {
+	for _, result := range []layer4.Result{
+		layer4.NotRun,
+		layer4.Passed,
+		layer4.Failed,
+		layer4.NeedsReview,
+		layer4.NotApplicable,
+		layer4.Unknown,
+	} {
+		if result.String() == value {
+			return result, nil
+		}
+	}
+	return layer4.Unknown, fmt.Errorf("unknown assessment result %q", value)
+}
@@ -1,6 +1,7 @@
 package proofwatch
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -77,7 +78,7 @@ func TestGemaraEvidenceTimestamp(t *testing.T) {
 
 			ts := evidence.Timestamp()
 			if tt.expectErr {
-				assert.WithinDuration(t, time.Now(), ts, time.Second)
+				assert.True(t, ts.IsZero(), "invalid timestamps should return the zero time")
 			} else {
 				expected, err := time.Parse(time.RFC3339, tt.endTime)
 				require.NoError(t, err)
@@ -174,6 +175,22 @@ func TestGemaraEvidenceAttributesDifferentResults(t *testing.T) {
 }
 
 // This remains the canonical helper for Gemara evidence tests.
+func TestGemaraEvidenceJSONRoundTrip(t *testing.T) {
+	evidence := createTestGemaraEvidence()
+	evidence.Result = layer4.NeedsReview
+
+	data, err := evidence.ToJSON()
+	require.NoError(t, err)
+
+	var decoded GemaraEvidence
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, layer4.NeedsReview, decoded.Result)
+	assert.Equal(t, evidence.Attributes(), decoded.Attributes())
+
+	err = json.Unmarshal([]byte(`{"result": "Maybe"}`), &decoded)
+	assert.ErrorContains(t, err, "unknown assessment result")
+}
+
 func createTestGemaraEvidence() GemaraEvidence {
 	return GemaraEvidence{
 		Metadata: layer4.Metadata{
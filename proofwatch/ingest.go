@@ -0,0 +1,71 @@
+package proofwatch
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+)
+
+// ndjsonEnvelope is a single line of an NDJSON evidence file. Kind selects the
+// decoder from the registry, the same as the Receiver's path parameter.
+type ndjsonEnvelope struct {
+	Kind     string          `json:"kind"`
+	Evidence json.RawMessage `json:"evidence"`
+}
+
+// IngestNDJSON streams newline-delimited evidence from r and logs each item
+// through pw. Every line is an object of the form
+//
+//	{"kind": "ocsf", "evidence": {...}}
+//
+// where kind is decoded with the DefaultRegistry. Lines that cannot be decoded
+// or logged are counted as failed and skipped; blank lines are ignored. An
+// error is returned only if reading r fails, a line exceeds
+// DefaultMaxEvidenceBytes, or ctx is done.
+func IngestNDJSON(ctx context.Context, r io.Reader, pw *ProofWatch) (processed, failed int, err error) {
+	registry := DefaultRegistry()
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), int(DefaultMaxEvidenceBytes))
+
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		if err := ctx.Err(); err != nil {
+			return processed, failed, err
+		}
+
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		if err := ingestLine(ctx, line, registry, pw); err != nil {
+			log.Printf("skipping evidence on line %d: %v", lineNum, err)
+			failed++
+			continue
+		}
+		processed++
+	}
+	if err := scanner.Err(); err != nil {
+		return processed, failed, fmt.Errorf("failed to read evidence on line %d: %w", lineNum+1, err)
+	}
+	return processed, failed, nil
+}
+
+// ingestLine decodes a single NDJSON envelope and logs the evidence.
+func ingestLine(ctx context.Context, line []byte, registry Registry, pw *ProofWatch) error {
+	var envelope ndjsonEnvelope
+	if err := json.Unmarshal(line, &envelope); err != nil {
+		return fmt.Errorf("failed to decode line: %w", err)
+	}
+	evidence, err := pw.decode(registry, envelope.Kind, envelope.Evidence)
+	if err != nil {
+		return err
+	}
+	return pw.Log(ctx, evidence)
+}
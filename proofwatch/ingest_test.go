@@ -0,0 +1,71 @@
+package proofwatch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func setupIngestTest(t *testing.T) (*ProofWatch, *recordingLoggerProvider) {
+	recorder := &recordingLoggerProvider{}
+	pw, err := NewProofWatch(
+		WithLoggerProvider(recorder),
+		WithMeterProvider(sdkmetric.NewMeterProvider()),
+		WithTracerProvider(sdktrace.NewTracerProvider()),
+	)
+	require.NoError(t, err)
+	return pw, recorder
+}
+
+func TestIngestNDJSON(t *testing.T) {
+	lines := []string{
+		`{"kind": "ocsf", "evidence": {"time": 1741944413000, "status": "success", "policy": {"uid": "ocsf-policy"}, "metadata": {"product": {"name": "opa"}, "version": "1.5.0"}}}`,
+		`{"kind": "vulnerability", "evidence": {"Scanner": "trivy", "VulnerabilityID": "CVE-2024-0001", "Severity": "HIGH", "CreatedAt": "2025-01-15T10:30:00Z"}}`,
+		``,
+		`{"kind": "ocsf", "evidence": {not json}}`,
+		`{"kind": "unknown", "evidence": {}}`,
+		`this line is not json`,
+		`{"kind": "gemara", "evidence": {"end": "2025-01-15T10:30:00Z", "result": "Passed", "requirement-id": "AC-1"}}`,
+	}
+	path := filepath.Join(t.TempDir(), "evidence.ndjson")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o600))
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	pw, recorder := setupIngestTest(t)
+	processed, failed, err := IngestNDJSON(context.Background(), file, pw)
+	require.NoError(t, err)
+	assert.Equal(t, 3, processed)
+	assert.Equal(t, 3, failed)
+	assert.Len(t, recorder.recordedLogs(), 3)
+}
+
+func TestIngestNDJSONCanceledContext(t *testing.T) {
+	pw, recorder := setupIngestTest(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	r := strings.NewReader(`{"kind": "vulnerability", "evidence": {"VulnerabilityID": "CVE-2024-0001", "CreatedAt": "2025-01-15T10:30:00Z"}}`)
+	processed, failed, err := IngestNDJSON(ctx, r, pw)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Zero(t, processed)
+	assert.Zero(t, failed)
+	assert.Empty(t, recorder.recordedLogs())
+}
+
+func TestIngestNDJSONLineTooLong(t *testing.T) {
+	pw, _ := setupIngestTest(t)
+	r := strings.NewReader(strings.Repeat("x", int(DefaultMaxEvidenceBytes)+1))
+
+	_, _, err := IngestNDJSON(context.Background(), r, pw)
+	assert.ErrorContains(t, err, "line 1")
+}
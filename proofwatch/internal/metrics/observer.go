@@ -13,6 +13,10 @@ type EvidenceObserver struct {
 	meter          *metric.Meter
 	droppedCounter metric.Int64Counter
 	processedCount metric.Int64Counter
+	decisionCount  metric.Int64Counter
+	// defaultedCount counts evidence logged with the current time because it
+	// had no valid timestamp.
+	defaultedCount metric.Int64Counter
 }
 
 // NewEvidenceObserver creates a new EvidenceObserver and registers the callback.
@@ -39,6 +43,22 @@ func NewEvidenceObserver(meter metric.Meter) (*EvidenceObserver, error) {
 		return nil, fmt.Errorf("failed to create processed counter: %w", err)
 	}
 
+	co.decisionCount, err = meter.Int64Counter(
+		"evidence_decision_count",
+		metric.WithDescription("The total number of evidence items processed by policy decision and source."),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create decision counter: %w", err)
+	}
+
+	co.defaultedCount, err = meter.Int64Counter(
+		"evidence_timestamp_defaulted_count",
+		metric.WithDescription("The total number of evidence items without a valid timestamp logged with the current time instead."),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create timestamp defaulted counter: %w", err)
+	}
+
 	return co, nil
 }
 
@@ -49,3 +69,28 @@ func (e *EvidenceObserver) Dropped(ctx context.Context, attrs ...attribute.KeyVa
 func (e *EvidenceObserver) Processed(ctx context.Context, attrs ...attribute.KeyValue) {
 	e.processedCount.Add(ctx, 1, metric.WithAttributes(attrs...))
 }
+
+// unknownLabel replaces an empty decision or source label.
+const unknownLabel = "unknown"
+
+// Decision counts a processed evidence item under its policy decision and source.
+// Only these two attributes are recorded to keep the metric cardinality bounded.
+// An empty decision or source is counted as "unknown".
+func (e *EvidenceObserver) Decision(ctx context.Context, decision, source string) {
+	if decision == "" {
+		decision = unknownLabel
+	}
+	if source == "" {
+		source = unknownLabel
+	}
+	e.decisionCount.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("decision", decision),
+		attribute.String("source", source),
+	))
+}
+
+// TimestampDefaulted counts an evidence item logged with the current time
+// because it had no valid timestamp.
+func (e *EvidenceObserver) TimestampDefaulted(ctx context.Context) {
+	e.defaultedCount.Add(ctx, 1)
+}
@@ -66,6 +66,8 @@ func TestNewEvidenceObserver(t *testing.T) {
 		assert.NotNil(t, observer.meter)
 		assert.NotNil(t, observer.droppedCounter)
 		assert.NotNil(t, observer.processedCount)
+		assert.NotNil(t, observer.decisionCount)
+		assert.NotNil(t, observer.defaultedCount)
 	})
 
 	t.Run("constructs with manual reader", func(t *testing.T) {
@@ -205,6 +207,67 @@ func TestEvidenceObserverBothMetrics(t *testing.T) {
 	})
 }
 
+func TestEvidenceObserverDecisionCounts(t *testing.T) {
+	fixture := setupEvidenceObserverTest(t)
+	ctx := context.Background()
+
+	fixture.observer.Decision(ctx, "Passed", "opa")
+	fixture.observer.Decision(ctx, "Passed", "opa")
+	fixture.observer.Decision(ctx, "Failed", "opa")
+	fixture.observer.Decision(ctx, "Passed", "kyverno")
+
+	rm := fixture.collectMetrics(ctx)
+
+	counts := map[[2]string]int64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != "evidence_decision_count" {
+				continue
+			}
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			require.True(t, ok, "expected an int64 sum")
+			assert.True(t, sum.IsMonotonic)
+			for _, dp := range sum.DataPoints {
+				decision, _ := dp.Attributes.Value("decision")
+				source, _ := dp.Attributes.Value("source")
+				counts[[2]string{decision.AsString(), source.AsString()}] = dp.Value
+			}
+		}
+	}
+
+	assert.Equal(t, map[[2]string]int64{
+		{"Passed", "opa"}:     2,
+		{"Failed", "opa"}:     1,
+		{"Passed", "kyverno"}: 1,
+	}, counts)
+}
+
+func TestEvidenceObserverDecisionMissingLabels(t *testing.T) {
+	fixture := setupEvidenceObserverTest(t)
+	ctx := context.Background()
+
+	fixture.observer.Decision(ctx, "", "")
+
+	rm := fixture.collectMetrics(ctx)
+	var found bool
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != "evidence_decision_count" {
+				continue
+			}
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			require.True(t, ok, "expected an int64 sum")
+			require.Len(t, sum.DataPoints, 1)
+			decision, _ := sum.DataPoints[0].Attributes.Value("decision")
+			source, _ := sum.DataPoints[0].Attributes.Value("source")
+			assert.Equal(t, "unknown", decision.AsString())
+			assert.Equal(t, "unknown", source.AsString())
+			found = true
+		}
+	}
+	assert.True(t, found, "expected decision metric to be present")
+}
+
 func TestEvidenceObserverConcurrentRecording(t *testing.T) {
 	fixture := setupEvidenceObserverTest(t)
 	ctx := context.Background()
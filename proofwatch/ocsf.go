@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"log"
+	"strings"
 	"time"
 
 	ocsf "github.com/Santiago-Labs/go-ocsf/ocsf/v1_5_0"
@@ -18,14 +19,36 @@ var _ Evidence = (*OCSFEvidence)(nil)
 type OCSFEvidence struct {
 	ocsf.ScanActivity `json:",inline"`
 	// From the security-control profile
-	Policy        ocsf.Policy `json:"policy" parquet:"policy"`
-	Action        *string     `json:"action,omitempty" parquet:"action,optional"`
-	ActionID      *int32      `json:"action_id,omitempty" parquet:"action_id,optional"`
-	Disposition   *string     `json:"disposition,omitempty" parquet:"action,optional"`
-	DispositionID *int32      `json:"disposition_id,omitempty" parquet:"action_id,optional"`
+	Policy ocsf.Policy `json:"policy" parquet:"policy"`
+	// Policies lists further policies evaluated by the same activity. Each
+	// policy is logged as a separate record.
+	Policies      []ocsf.Policy `json:"policies,omitempty" parquet:"policies,optional"`
+	Action        *string       `json:"action,omitempty" parquet:"action,optional"`
+	ActionID      *int32        `json:"action_id,omitempty" parquet:"action_id,optional"`
+	Disposition   *string       `json:"disposition,omitempty" parquet:"disposition,optional"`
+	DispositionID *int32        `json:"disposition_id,omitempty" parquet:"disposition_id,optional"`
+	// From the Findings category classes
+	FindingInfo *ocsf.FindingInformation `json:"finding_info,omitempty" parquet:"finding_info,optional"`
+	Compliance  *ocsf.Compliance         `json:"compliance,omitempty" parquet:"compliance,optional"`
+}
+
+// OCSF class identifiers with class-specific attribute extraction.
+const (
+	ClassComplianceFinding int32 = 2003
+	ClassDetectionFinding  int32 = 2004
+)
+
+// classAttributes selects the attribute extraction for an OCSF class_uid.
+// Classes without an entry are treated as Scan Activity.
+var classAttributes = map[int32]func(OCSFEvidence) []attribute.KeyValue{
+	ClassComplianceFinding: OCSFEvidence.complianceFindingAttributes,
+	ClassDetectionFinding:  OCSFEvidence.detectionFindingAttributes,
 }
 
 func (o OCSFEvidence) Timestamp() time.Time {
+	if o.Time <= 0 {
+		return time.Time{}
+	}
 	return time.UnixMilli(o.Time)
 }
 
@@ -33,37 +56,171 @@ func (o OCSFEvidence) ToJSON() ([]byte, error) {
 	return json.Marshal(o)
 }
 
+// Attributes describes the evaluation of the event's policy. For an event
+// that evaluated several policies, only the first is described; use
+// SplitEvidence to get every evaluation.
 func (o OCSFEvidence) Attributes() []attribute.KeyValue {
+	if split := o.split(); len(split) > 0 {
+		return split[0].Attributes()
+	}
+	if extract, ok := classAttributes[o.ClassUid]; ok {
+		return extract(o)
+	}
+	return o.scanActivityAttributes()
+}
+
+// scanActivityAttributes extracts attributes from a Scan Activity event, where
+// the policy and its evaluation status describe the result.
+func (o OCSFEvidence) scanActivityAttributes() []attribute.KeyValue {
 	// Validate critical fields - log warnings for missing data but continue processing
 	// This allows the pipeline to continue even with incomplete data
 	if err := validateEvidenceFields(o); err != nil {
 		log.Printf("validation error %v, using default values", err)
 	}
 
+	return o.attributes(o.Policy.Uid, o.Policy.Name, mapScanStatus(o.ActivityId, o.StatusId, o.Status))
+}
+
+// complianceFindingAttributes extracts attributes from a Compliance Finding,
+// where the compliance check status describes the result. The finding is used
+// to identify the rule when the event has no policy.
+func (o OCSFEvidence) complianceFindingAttributes() []attribute.KeyValue {
+	ruleID, ruleName := o.findingRule()
+	var statusID *int32
+	var status *string
+	if o.Compliance != nil {
+		statusID, status = o.Compliance.StatusId, o.Compliance.Status
+	}
+	return o.attributes(ruleID, ruleName, mapComplianceCheckStatus(statusID, status))
+}
+
+// detectionFindingAttributes extracts attributes from a Detection Finding.
+// A detection is a policy violation until it is resolved or suppressed, so the
+// result is derived from the finding status rather than a scan outcome.
+func (o OCSFEvidence) detectionFindingAttributes() []attribute.KeyValue {
+	ruleID, ruleName := o.findingRule()
+	return o.attributes(ruleID, ruleName, mapDetectionStatus(o.StatusId))
+}
+
+// split returns one event per evaluated policy: the policy, if set, followed
+// by the policies list. Each event shares the activity's status and targets.
+func (o OCSFEvidence) split() []Evidence {
+	if len(o.Policies) == 0 {
+		return nil
+	}
+	policies := make([]ocsf.Policy, 0, len(o.Policies)+1)
+	if o.Policy.Uid != nil || o.Policy.Name != nil {
+		policies = append(policies, o.Policy)
+	}
+	policies = append(policies, o.Policies...)
+
+	split := make([]Evidence, 0, len(policies))
+	for _, policy := range policies {
+		single := o
+		single.Policy = policy
+		single.Policies = nil
+		split = append(split, single)
+	}
+	return split
+}
+
+// missingStatus reports whether the evidence carries nothing to derive its
+// evaluation result from, leaving it Unknown. Detection findings always have a
+// result, since an open detection is a failure.
+func (o OCSFEvidence) missingStatus() bool {
+	switch o.ClassUid {
+	case ClassComplianceFinding:
+		return o.Compliance == nil || o.Compliance.StatusId == nil
+	case ClassDetectionFinding:
+		return false
+	}
+	if o.ActivityId == scanActivityCancelled {
+		return false
+	}
+	if o.StatusId != nil && (*o.StatusId == 1 || *o.StatusId == 2) {
+		return false
+	}
+	return o.Status == nil || strings.TrimSpace(*o.Status) == ""
+}
+
+// findingRule returns the policy identity of a finding, falling back to the
+// finding information when the event has no policy.
+func (o OCSFEvidence) findingRule() (*string, *string) {
+	ruleID, ruleName := o.Policy.Uid, o.Policy.Name
+	if o.FindingInfo != nil {
+		if ruleID == nil && o.FindingInfo.Uid != "" {
+			ruleID = &o.FindingInfo.Uid
+		}
+		if ruleName == nil {
+			ruleName = o.FindingInfo.Title
+		}
+	}
+	return ruleID, ruleName
+}
+
+// attributes builds the attributes shared by all OCSF classes.
+func (o OCSFEvidence) attributes(ruleID, ruleName *string, result string) []attribute.KeyValue {
 	attrs := []attribute.KeyValue{
 
-		attribute.String(POLICY_RULE_ID, stringVal(o.Policy.Uid, "unknown_policy_id")),
-		attribute.String(POLICY_RULE_NAME, stringVal(o.Policy.Name, "unknown_policy_name")),
+		attribute.String(POLICY_RULE_ID, stringVal(ruleID, "unknown_policy_id")),
+		attribute.String(POLICY_RULE_NAME, stringVal(ruleName, "unknown_policy_name")),
 		attribute.String(POLICY_ENGINE_NAME, stringVal(o.Metadata.Product.Name, "unknown_source")),
+		attribute.String(POLICY_ENGINE_VERSION, stringVal(o.Metadata.Product.Version, "unknown_version")),
 
-		attribute.String(POLICY_EVALUATION_RESULT, mapEvaluationStatus(o.Status)),
+		attribute.String(POLICY_EVALUATION_RESULT, result),
 		attribute.String(POLICY_EVALUATION_MESSAGE, stringVal(o.Message, "")),
 
 		attribute.String(COMPLIANCE_REMEDIATION_ACTION, mapEnforcementAction(o.ActionID, o.DispositionID)),
 		attribute.String(COMPLIANCE_REMEDIATION_STATUS, mapEnforcementStatus(o.ActionID, o.DispositionID)),
 	}
 
-	// Add target information if available
-	if o.Scan.Uid != nil && *o.Scan.Uid != "" {
+	return append(attrs, o.targetAttributes()...)
+}
+
+// targetAttributes describes the evaluated targets. The first observable with
+// a value is the primary target; when there are several, all of them are
+// listed as well. Without observables, the scan identifies the target.
+func (o OCSFEvidence) targetAttributes() []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	targets := o.observableTargets()
+
+	targetType := o.Scan.Type
+	if len(targets) > 0 {
+		attrs = append(attrs, attribute.String(POLICY_TARGET_ID, *targets[0].Value))
+		if targets[0].Type != nil {
+			targetType = targets[0].Type
+		}
+	} else if o.Scan.Uid != nil && *o.Scan.Uid != "" {
 		attrs = append(attrs, attribute.String(POLICY_TARGET_ID, *o.Scan.Uid))
 	}
-	if o.Scan.Type != nil && *o.Scan.Type != "" {
-		attrs = append(attrs, attribute.String(POLICY_TARGET_TYPE, *o.Scan.Type))
+	if len(targets) > 1 {
+		ids := make([]string, 0, len(targets))
+		for _, target := range targets {
+			ids = append(ids, *target.Value)
+		}
+		attrs = append(attrs, attribute.StringSlice(POLICY_TARGET_IDS, ids))
+	}
+	if o.Scan.Name != nil && *o.Scan.Name != "" {
+		attrs = append(attrs, attribute.String(POLICY_TARGET_NAME, *o.Scan.Name))
+	}
+	if targetType != nil && *targetType != "" {
+		attrs = append(attrs, attribute.String(POLICY_TARGET_TYPE, *targetType))
 	}
-
 	return attrs
 }
 
+// observableTargets returns the observables that carry a value. Observables
+// referring to object attributes have no value and cannot identify a target.
+func (o OCSFEvidence) observableTargets() []*ocsf.Observable {
+	var targets []*ocsf.Observable
+	for _, observable := range o.Observables {
+		if observable != nil && observable.Value != nil && *observable.Value != "" {
+			targets = append(targets, observable)
+		}
+	}
+	return targets
+}
+
 // stringVal safely dereferences a string pointer with a default value.
 func stringVal(s *string, defaultValue string) string {
 	if s != nil {
@@ -72,22 +229,99 @@ func stringVal(s *string, defaultValue string) string {
 	return defaultValue
 }
 
-// mapEvaluationStatus provides the core GRC logic for a pass/fail/error status.
-// This is custom logic based on the policy engine's output.
+// scanActivityCancelled is the Scan Activity activity_id of a cancelled scan.
+const scanActivityCancelled int32 = 3
+
+// evaluationResults maps OCSF status captions, along with the statuses policy
+// engines commonly report for status_id 99 (Other), to evaluation results.
+// Keys are lowercase with underscores and hyphens replaced by spaces.
+var evaluationResults = map[string]string{
+	"success":        "Passed",
+	"pass":           "Passed",
+	"passed":         "Passed",
+	"failure":        "Failed",
+	"fail":           "Failed",
+	"failed":         "Failed",
+	"warning":        "Needs Review",
+	"needs review":   "Needs Review",
+	"manual":         "Needs Review",
+	"not applicable": "Not Applicable",
+	"n/a":            "Not Applicable",
+	"not run":        "Not Run",
+	"skipped":        "Not Run",
+	"cancelled":      "Not Run",
+}
+
+var statusNormalizer = strings.NewReplacer("_", " ", "-", " ")
+
+// mapEvaluationStatus provides the core GRC logic for a status reported by the
+// policy engine, such as "success", "failure", or "not_applicable". Statuses
+// without an entry in evaluationResults are Unknown.
 func mapEvaluationStatus(status *string) string {
 	if status == nil {
 		return "Unknown"
 	}
-	switch *status {
-	case "success":
+	if result, ok := evaluationResults[statusNormalizer.Replace(strings.ToLower(strings.TrimSpace(*status)))]; ok {
+		return result
+	}
+	return "Unknown"
+}
+
+// mapScanStatus maps the outcome of a Scan Activity to an evaluation result.
+// A cancelled scan was not run. Otherwise the normalized status_id decides,
+// and the status caption is only consulted for Unknown (0) and Other (99).
+func mapScanStatus(activityID int32, statusID *int32, status *string) string {
+	if activityID == scanActivityCancelled {
+		return "Not Run"
+	}
+	if statusID != nil {
+		switch *statusID {
+		case 1: // Success
+			return "Passed"
+		case 2: // Failure
+			return "Failed"
+		}
+	}
+	return mapEvaluationStatus(status)
+}
+
+// mapComplianceCheckStatus maps an OCSF compliance status_id to an evaluation
+// result. For Other (99), the source-defined status is mapped instead.
+func mapComplianceCheckStatus(statusID *int32, status *string) string {
+	if statusID == nil {
+		return "Unknown"
+	}
+	switch *statusID {
+	case 1: // Pass
 		return "Passed"
-	case "failure":
+	case 2: // Warning
+		return "Needs Review"
+	case 3: // Fail
 		return "Failed"
+	case 99: // Other
+		return mapEvaluationStatus(status)
 	default:
 		return "Unknown"
 	}
 }
 
+// mapDetectionStatus maps an OCSF finding status_id to an evaluation result.
+// Open detections are failures; resolved ones pass and suppressed ones no
+// longer apply.
+func mapDetectionStatus(statusID *int32) string {
+	if statusID == nil {
+		return "Failed"
+	}
+	switch *statusID {
+	case 3: // Suppressed
+		return "Not Applicable"
+	case 4: // Resolved
+		return "Passed"
+	default: // New, In Progress, Archived, Other
+		return "Failed"
+	}
+}
+
 // mapEnforcementAction provides the core GRC logic for block/mutate/audit.
 func mapEnforcementAction(actionID *int32, dispositionID *int32) string {
 	if actionID == nil {
@@ -1,11 +1,13 @@
 package proofwatch
 
 import (
+	"reflect"
 	"testing"
 	"time"
 
 	ocsf "github.com/Santiago-Labs/go-ocsf/ocsf/v1_5_0"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestOCSFEvidenceAttributes(t *testing.T) {
@@ -22,6 +24,7 @@ func TestOCSFEvidenceAttributes(t *testing.T) {
 	assert.Equal(t, "test-policy", attrMap[POLICY_RULE_ID])
 	assert.Equal(t, "test-policy", attrMap[POLICY_RULE_NAME])
 	assert.Equal(t, "test-product", attrMap[POLICY_ENGINE_NAME])
+	assert.Equal(t, "unknown_version", attrMap[POLICY_ENGINE_VERSION])
 
 	// Verify evaluation status mapping
 	assert.Equal(t, "Passed", attrMap[POLICY_EVALUATION_RESULT])
@@ -43,6 +46,36 @@ func TestMapEvaluationStatus(t *testing.T) {
 			status:   stringPtr("failure"),
 			expected: "Failed",
 		},
+		{
+			name:     "status is case insensitive",
+			status:   stringPtr("Success"),
+			expected: "Passed",
+		},
+		{
+			name:     "not applicable status",
+			status:   stringPtr("not_applicable"),
+			expected: "Not Applicable",
+		},
+		{
+			name:     "skipped status",
+			status:   stringPtr("skipped"),
+			expected: "Not Run",
+		},
+		{
+			name:     "not run status",
+			status:   stringPtr("Not Run"),
+			expected: "Not Run",
+		},
+		{
+			name:     "warning status",
+			status:   stringPtr("warning"),
+			expected: "Needs Review",
+		},
+		{
+			name:     "manual review status",
+			status:   stringPtr("needs-review"),
+			expected: "Needs Review",
+		},
 		{
 			name:     "unknown status",
 			status:   stringPtr("unknown"),
@@ -63,6 +96,136 @@ func TestMapEvaluationStatus(t *testing.T) {
 	}
 }
 
+func TestMapScanStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		activityID int32
+		statusID   *int32
+		status     *string
+		expected   string
+	}{
+		{
+			name:     "success status id",
+			statusID: int32Ptr(1),
+			status:   stringPtr("Success"),
+			expected: "Passed",
+		},
+		{
+			name:     "failure status id",
+			statusID: int32Ptr(2),
+			status:   stringPtr("Failure"),
+			expected: "Failed",
+		},
+		{
+			name:     "status id takes precedence over the caption",
+			statusID: int32Ptr(2),
+			status:   stringPtr("success"),
+			expected: "Failed",
+		},
+		{
+			name:     "other status id uses the source status",
+			statusID: int32Ptr(99),
+			status:   stringPtr("not applicable"),
+			expected: "Not Applicable",
+		},
+		{
+			name:     "unknown status id uses the source status",
+			statusID: int32Ptr(0),
+			status:   stringPtr("warning"),
+			expected: "Needs Review",
+		},
+		{
+			name:       "cancelled scan was not run",
+			activityID: 3,
+			statusID:   int32Ptr(1),
+			status:     stringPtr("success"),
+			expected:   "Not Run",
+		},
+		{
+			name:     "no status id uses the status",
+			status:   stringPtr("failure"),
+			expected: "Failed",
+		},
+		{
+			name:     "nothing reported",
+			expected: "Unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, mapScanStatus(tt.activityID, tt.statusID, tt.status))
+		})
+	}
+
+	t.Run("scan activity evidence", func(t *testing.T) {
+		evidence := createTestEvidence()
+		evidence.StatusId = int32Ptr(99)
+		evidence.Status = stringPtr("Not Applicable")
+
+		attrMap := make(map[string]interface{})
+		for _, attr := range evidence.Attributes() {
+			attrMap[string(attr.Key)] = attr.Value.AsInterface()
+		}
+		assert.Equal(t, "Not Applicable", attrMap[POLICY_EVALUATION_RESULT])
+	})
+}
+
+func TestOCSFEvidenceMissingStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		evidence OCSFEvidence
+		expected bool
+	}{
+		{
+			name:     "scan with status",
+			evidence: OCSFEvidence{ScanActivity: ocsf.ScanActivity{Status: stringPtr("success")}},
+		},
+		{
+			name:     "scan without status",
+			evidence: OCSFEvidence{},
+			expected: true,
+		},
+		{
+			name:     "scan with blank status",
+			evidence: OCSFEvidence{ScanActivity: ocsf.ScanActivity{Status: stringPtr(" ")}},
+			expected: true,
+		},
+		{
+			name:     "scan with status id only",
+			evidence: OCSFEvidence{ScanActivity: ocsf.ScanActivity{StatusId: int32Ptr(2)}},
+		},
+		{
+			name:     "cancelled scan",
+			evidence: OCSFEvidence{ScanActivity: ocsf.ScanActivity{ActivityId: 3}},
+		},
+		{
+			name: "compliance finding without compliance status",
+			evidence: OCSFEvidence{
+				ScanActivity: ocsf.ScanActivity{ClassUid: ClassComplianceFinding, Status: stringPtr("success")},
+			},
+			expected: true,
+		},
+		{
+			name: "compliance finding with compliance status",
+			evidence: OCSFEvidence{
+				ScanActivity: ocsf.ScanActivity{ClassUid: ClassComplianceFinding},
+				Compliance:   &ocsf.Compliance{StatusId: int32Ptr(1)},
+			},
+		},
+		{
+			name:     "detection finding",
+			evidence: OCSFEvidence{ScanActivity: ocsf.ScanActivity{ClassUid: ClassDetectionFinding}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.evidence.missingStatus())
+		})
+	}
+}
+
 func TestMapEnforcementAction(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -325,6 +488,30 @@ func int32Ptr(i int32) *int32 {
 }
 
 // Helper function to create test evidence
+func TestOCSFEvidenceEngineVersion(t *testing.T) {
+	evidence := createTestEvidence()
+	evidence.Metadata.Product.Version = stringPtr("v0.45.0")
+
+	attrMap := make(map[string]interface{})
+	for _, attr := range evidence.Attributes() {
+		attrMap[string(attr.Key)] = attr.Value.AsInterface()
+	}
+
+	assert.Equal(t, "test-product", attrMap[POLICY_ENGINE_NAME])
+	assert.Equal(t, "v0.45.0", attrMap[POLICY_ENGINE_VERSION])
+}
+
+func TestOCSFEvidenceTimestamp(t *testing.T) {
+	eventTime := time.Date(2025, 3, 14, 9, 26, 53, 0, time.UTC)
+
+	evidence := createTestEvidence()
+	evidence.Time = eventTime.UnixMilli()
+	assert.True(t, eventTime.Equal(evidence.Timestamp()))
+
+	evidence.Time = 0
+	assert.True(t, evidence.Timestamp().IsZero(), "zero time should not be reported as the epoch")
+}
+
 func createTestEvidence() OCSFEvidence {
 	policyName := "test-policy"
 	productName := "test-product"
@@ -350,6 +537,7 @@ func createTestEvidence() OCSFEvidence {
 func TestOCSFEvidenceTargetAttributes(t *testing.T) {
 	scanUid := "scan-123"
 	scanType := "vulnerability"
+	scanName := "weekly-repo-scan"
 	policyName := "test-policy"
 	productName := "test-product"
 	status := "success"
@@ -365,6 +553,7 @@ func TestOCSFEvidenceTargetAttributes(t *testing.T) {
 			Status: &status,
 			Scan: ocsf.Scan{
 				Uid:  &scanUid,
+				Name: &scanName,
 				Type: &scanType,
 			},
 		},
@@ -382,5 +571,189 @@ func TestOCSFEvidenceTargetAttributes(t *testing.T) {
 
 	// Verify target attributes are present
 	assert.Equal(t, scanUid, attrMap[POLICY_TARGET_ID])
+	assert.Equal(t, scanName, attrMap[POLICY_TARGET_NAME])
 	assert.Equal(t, scanType, attrMap[POLICY_TARGET_TYPE])
 }
+
+func TestOCSFEvidenceObservableTargets(t *testing.T) {
+	scanUid := "scan-123"
+	scanType := "vulnerability"
+	resourceType := "Resource UID"
+
+	observable := func(value string, observableType *string) *ocsf.Observable {
+		return &ocsf.Observable{Value: &value, Type: observableType}
+	}
+
+	tests := []struct {
+		name         string
+		observables  []*ocsf.Observable
+		expectedID   string
+		expectedIDs  []string
+		expectedType string
+	}{
+		{
+			name:         "zero observables fall back to the scan",
+			expectedID:   scanUid,
+			expectedType: scanType,
+		},
+		{
+			name:         "single observable is the target",
+			observables:  []*ocsf.Observable{observable("deployment-123", &resourceType)},
+			expectedID:   "deployment-123",
+			expectedType: resourceType,
+		},
+		{
+			name: "multiple observables are listed",
+			observables: []*ocsf.Observable{
+				observable("deployment-123", &resourceType),
+				observable("deployment-456", nil),
+			},
+			expectedID:   "deployment-123",
+			expectedIDs:  []string{"deployment-123", "deployment-456"},
+			expectedType: resourceType,
+		},
+		{
+			name: "observables without values are skipped",
+			observables: []*ocsf.Observable{
+				nil,
+				{TypeId: 99},
+				observable("deployment-456", nil),
+			},
+			expectedID:   "deployment-456",
+			expectedType: scanType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evidence := createTestEvidence()
+			evidence.Scan = ocsf.Scan{Uid: &scanUid, Type: &scanType}
+			evidence.Observables = tt.observables
+
+			attrMap := attrsToMap(t, evidence.Attributes())
+
+			assert.Equal(t, tt.expectedID, attrMap[POLICY_TARGET_ID])
+			assert.Equal(t, tt.expectedType, attrMap[POLICY_TARGET_TYPE])
+			if tt.expectedIDs == nil {
+				assert.NotContains(t, attrMap, POLICY_TARGET_IDS)
+			} else {
+				assert.Equal(t, tt.expectedIDs, attrMap[POLICY_TARGET_IDS])
+			}
+		})
+	}
+}
+
+func TestOCSFEvidenceActionDispositionRoundTrip(t *testing.T) {
+	action := "Denied"
+	actionID := int32(2)
+	disposition := "Blocked"
+	dispositionID := int32(6)
+
+	evidence := createTestEvidence()
+	evidence.Action = &action
+	evidence.ActionID = &actionID
+	evidence.Disposition = &disposition
+	evidence.DispositionID = &dispositionID
+
+	data, err := evidence.ToJSON()
+	require.NoError(t, err)
+
+	decoded, err := DefaultRegistry().Decode(KindOCSF, data)
+	require.NoError(t, err)
+	roundTripped, ok := decoded.(OCSFEvidence)
+	require.True(t, ok)
+
+	require.NotNil(t, roundTripped.Action)
+	require.NotNil(t, roundTripped.ActionID)
+	require.NotNil(t, roundTripped.Disposition)
+	require.NotNil(t, roundTripped.DispositionID)
+	assert.Equal(t, action, *roundTripped.Action)
+	assert.Equal(t, actionID, *roundTripped.ActionID)
+	assert.Equal(t, disposition, *roundTripped.Disposition)
+	assert.Equal(t, dispositionID, *roundTripped.DispositionID)
+
+	// Parquet columns are named by tag, so the fields must not share one.
+	columns := make(map[string]string)
+	evidenceType := reflect.TypeOf(OCSFEvidence{})
+	for _, field := range []string{"Action", "ActionID", "Disposition", "DispositionID"} {
+		structField, ok := evidenceType.FieldByName(field)
+		require.True(t, ok)
+		tag := structField.Tag.Get("parquet")
+		require.NotEmpty(t, tag)
+		assert.NotContains(t, columns, tag, "%s shares the parquet tag of %s", field, columns[tag])
+		columns[tag] = field
+	}
+}
+
+func TestOCSFEvidenceClassAttributes(t *testing.T) {
+	tests := []struct {
+		name           string
+		data           string
+		expectedRule   string
+		expectedName   string
+		expectedResult string
+	}{
+		{
+			name:           "scan activity uses policy status",
+			data:           `{"class_uid": 6007, "time": 1741944413000, "status": "success", "policy": {"uid": "scan-policy", "name": "Scan Policy"}, "metadata": {"product": {"name": "opa"}}}`,
+			expectedRule:   "scan-policy",
+			expectedName:   "Scan Policy",
+			expectedResult: "Passed",
+		},
+		{
+			name:           "compliance finding uses compliance status",
+			data:           `{"class_uid": 2003, "time": 1741944413000, "status": "New", "compliance": {"status_id": 3}, "finding_info": {"uid": "finding-1", "title": "Encryption at rest"}, "metadata": {"product": {"name": "opa"}}}`,
+			expectedRule:   "finding-1",
+			expectedName:   "Encryption at rest",
+			expectedResult: "Failed",
+		},
+		{
+			name:           "open detection finding fails",
+			data:           `{"class_uid": 2004, "time": 1741944413000, "status_id": 1, "policy": {"uid": "detect-policy"}, "finding_info": {"uid": "finding-2", "title": "Public bucket"}, "metadata": {"product": {"name": "falco"}}}`,
+			expectedRule:   "detect-policy",
+			expectedName:   "Public bucket",
+			expectedResult: "Failed",
+		},
+		{
+			name:           "resolved detection finding passes",
+			data:           `{"class_uid": 2004, "time": 1741944413000, "status_id": 4, "finding_info": {"uid": "finding-3"}, "metadata": {"product": {"name": "falco"}}}`,
+			expectedRule:   "finding-3",
+			expectedName:   "unknown_policy_name",
+			expectedResult: "Passed",
+		},
+	}
+
+	registry := DefaultRegistry()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evidence, err := registry.Decode(KindOCSF, []byte(tt.data))
+			require.NoError(t, err)
+
+			attrMap := make(map[string]interface{})
+			for _, attr := range evidence.Attributes() {
+				attrMap[string(attr.Key)] = attr.Value.AsInterface()
+			}
+			assert.Equal(t, tt.expectedRule, attrMap[POLICY_RULE_ID])
+			assert.Equal(t, tt.expectedName, attrMap[POLICY_RULE_NAME])
+			assert.Equal(t, tt.expectedResult, attrMap[POLICY_EVALUATION_RESULT])
+		})
+	}
+}
+
+func TestMapComplianceCheckStatus(t *testing.T) {
+	assert.Equal(t, "Unknown", mapComplianceCheckStatus(nil, nil))
+	assert.Equal(t, "Passed", mapComplianceCheckStatus(int32Ptr(1), nil))
+	assert.Equal(t, "Needs Review", mapComplianceCheckStatus(int32Ptr(2), nil))
+	assert.Equal(t, "Failed", mapComplianceCheckStatus(int32Ptr(3), nil))
+	assert.Equal(t, "Unknown", mapComplianceCheckStatus(int32Ptr(99), nil))
+	assert.Equal(t, "Not Applicable", mapComplianceCheckStatus(int32Ptr(99), stringPtr("Not Applicable")))
+	assert.Equal(t, "Not Run", mapComplianceCheckStatus(int32Ptr(99), stringPtr("skipped")))
+	assert.Equal(t, "Failed", mapComplianceCheckStatus(int32Ptr(3), stringPtr("not applicable")))
+}
+
+func TestMapDetectionStatus(t *testing.T) {
+	assert.Equal(t, "Failed", mapDetectionStatus(nil))
+	assert.Equal(t, "Failed", mapDetectionStatus(int32Ptr(1)))
+	assert.Equal(t, "Not Applicable", mapDetectionStatus(int32Ptr(3)))
+	assert.Equal(t, "Passed", mapDetectionStatus(int32Ptr(4)))
+}
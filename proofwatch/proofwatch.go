@@ -2,6 +2,9 @@ package proofwatch
 
 import (
 	"context"
+	"errors"
+	"slices"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/otel"
@@ -10,6 +13,7 @@ import (
 	"go.opentelemetry.io/otel/log/global"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/time/rate"
 
 	"github.com/complytime/complybeacon/proofwatch/internal/metrics"
 )
@@ -19,11 +23,36 @@ const (
 	ScopeName = "github.com/complytime/complybeacon/proofwatch"
 )
 
+// ErrInvalidTimestamp is returned in strict mode for evidence without a valid timestamp.
+var ErrInvalidTimestamp = errors.New("evidence has no valid timestamp")
+
+// ErrMissingStatus is returned in strict mode for evidence without an evaluation status.
+var ErrMissingStatus = errors.New("evidence has no evaluation status")
+
+// attributeBuffers hold the attribute slices built for each record. The log
+// record, span event, and metric attribute sets all copy the attributes they
+// keep, so the buffers go back to the pool once the record is emitted and
+// nothing returned from LogWithSeverity aliases them.
+var (
+	attributeBuffers    = sync.Pool{New: func() any { return new([]attribute.KeyValue) }}
+	logAttributeBuffers = sync.Pool{New: func() any { return new([]olog.KeyValue) }}
+)
+
 type ProofWatch struct {
-	logger        olog.Logger
-	tracer        trace.Tracer
-	observer      *metrics.EvidenceObserver
-	levelSeverity olog.Severity
+	logger           olog.Logger
+	tracer           trace.Tracer
+	observer         *metrics.EvidenceObserver
+	levelSeverity    olog.Severity
+	strictTimestamps bool
+	limiter          *rate.Limiter
+	// name is emitted as the collector name on every record when set.
+	name    string
+	sampler *sampler
+	// validateSchemas checks serialized evidence against its schema.
+	validateSchemas bool
+	// defaultResult replaces the result of evidence without a status.
+	defaultResult string
+	strictStatus  bool
 }
 
 // NewProofWatch creates a new ProofWatch instance with OpenTelemetry logging.
@@ -42,12 +71,27 @@ func NewProofWatch(opts ...OptionFunc) (*ProofWatch, error) {
 	if err != nil {
 		return nil, err
 	}
+	var limiter *rate.Limiter
+	if cfg.RateLimit > 0 {
+		limiter = rate.NewLimiter(rate.Limit(cfg.RateLimit), 1)
+	}
+	var sampler *sampler
+	if cfg.Sampling != nil {
+		sampler = newSampler(cfg.Sampling.Rate, cfg.Sampling.AlwaysKeepFailures)
+	}
 	return &ProofWatch{
 		logger:   cfg.LoggerProvider.Logger(ScopeName, olog.WithInstrumentationVersion(Version())),
 		tracer:   cfg.TracerProvider.Tracer(ScopeName, trace.WithInstrumentationVersion(Version())),
 		observer: observer,
 		// Default severity
-		levelSeverity: olog.SeverityInfo,
+		levelSeverity:    olog.SeverityInfo,
+		strictTimestamps: cfg.StrictTimestamps,
+		limiter:          limiter,
+		name:             cfg.Name,
+		sampler:          sampler,
+		validateSchemas:  cfg.SchemaValidation,
+		defaultResult:    cfg.DefaultResult,
+		strictStatus:     cfg.StrictStatus,
 	}, nil
 }
 
@@ -56,26 +100,72 @@ func (w *ProofWatch) Log(ctx context.Context, evidence Evidence) error {
 	return w.LogWithSeverity(ctx, evidence, w.levelSeverity)
 }
 
-// LogWithSeverity logs a policy event using OpenTelemetry's log API with a given severity level
+// LogWithSeverity logs a policy event using OpenTelemetry's log API with a given severity level.
+// Evidence left out by sampling is skipped without error. Evidence recording
+// several policy evaluations is logged as one record per evaluation; a failure
+// to log one evaluation does not stop the others.
 func (w *ProofWatch) LogWithSeverity(ctx context.Context, evidence Evidence, severity olog.Severity) error {
+	split := SplitEvidence(evidence)
+	if len(split) == 1 {
+		return w.logEvaluation(ctx, split[0], severity)
+	}
+	var errs []error
+	for _, e := range split {
+		if err := w.logEvaluation(ctx, e, severity); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// logEvaluation logs a single policy evaluation.
+func (w *ProofWatch) logEvaluation(ctx context.Context, evidence Evidence, severity olog.Severity) error {
+	buf := attributeBuffers.Get().(*[]attribute.KeyValue)
+	defer func() {
+		clear(*buf)
+		*buf = (*buf)[:0]
+		attributeBuffers.Put(buf)
+	}()
+
+	attrs, err := w.appendRecordAttributes((*buf)[:0], evidence)
+	*buf = attrs
+	if err != nil {
+		return err
+	}
+	if w.sampler != nil && !w.sampler.keep(attrs) {
+		return nil
+	}
+
+	if w.limiter != nil {
+		if err := w.limiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
 
 	ctx, span := w.tracer.Start(ctx, "evidence.log_evidence")
 	defer span.End()
 
-	attrs := evidence.Attributes()
-
 	jsonData, err := evidence.ToJSON()
 	if err != nil {
 		return err
 	}
 
+	timestamp, err := w.eventTime(ctx, evidence)
+	if err != nil {
+		return err
+	}
+
 	record := olog.Record{}
 	record.SetSeverity(severity)
 	record.SetSeverityText(severity.String())
 	record.SetObservedTimestamp(time.Now())
 	// Set event time
-	record.SetTimestamp(evidence.Timestamp())
-	record.AddAttributes(ToLogKeyValues(attrs)...)
+	record.SetTimestamp(timestamp)
+	logBuf := logAttributeBuffers.Get().(*[]olog.KeyValue)
+	*logBuf = appendLogKeyValues((*logBuf)[:0], attrs)
+	record.AddAttributes(*logBuf...)
+	clear(*logBuf)
+	logAttributeBuffers.Put(logBuf)
 	record.SetBody(olog.StringValue(string(jsonData))) // Retains the original body for flexibility.
 
 	span.AddEvent("evidence.logged", trace.WithAttributes(attrs...), trace.WithTimestamp(time.Now()))
@@ -83,20 +173,75 @@ func (w *ProofWatch) LogWithSeverity(ctx context.Context, evidence Evidence, sev
 	w.logger.Emit(ctx, record)
 
 	w.observer.Processed(ctx, attrs...)
+	w.observer.Decision(ctx, attributeValue(attrs, POLICY_EVALUATION_RESULT), attributeValue(attrs, POLICY_ENGINE_NAME))
 
 	return nil
 }
 
+// statusReporter is implemented by evidence that can lack an evaluation status.
+type statusReporter interface {
+	missingStatus() bool
+}
+
+// appendRecordAttributes appends the evidence attributes to dst along with
+// the attributes this instance adds to every record. Evidence without an
+// evaluation status is rejected in strict mode and otherwise reported with the
+// default result, if one is configured.
+func (w *ProofWatch) appendRecordAttributes(dst []attribute.KeyValue, evidence Evidence) ([]attribute.KeyValue, error) {
+	attrs := append(dst, evidence.Attributes()...)
+	if reporter, ok := evidence.(statusReporter); ok && reporter.missingStatus() {
+		switch {
+		case w.strictStatus:
+			return attrs, ErrMissingStatus
+		case w.defaultResult != "":
+			attrs = setAttribute(attrs, attribute.String(POLICY_EVALUATION_RESULT, w.defaultResult))
+		}
+	}
+	if w.name != "" {
+		attrs = append(attrs, attribute.String(EVIDENCE_COLLECTOR_NAME, w.name))
+	}
+	return attrs, nil
+}
+
+// setAttribute replaces the value of kv's key in attrs with kv, or appends kv
+// when the key is not present.
+func setAttribute(attrs []attribute.KeyValue, kv attribute.KeyValue) []attribute.KeyValue {
+	if i := slices.IndexFunc(attrs, func(attr attribute.KeyValue) bool { return attr.Key == kv.Key }); i >= 0 {
+		attrs[i] = kv
+		return attrs
+	}
+	return append(attrs, kv)
+}
+
 // ToLogKeyValues converts slice of attribute.KeyValue to log.KeyValue
 func ToLogKeyValues(attrs []attribute.KeyValue) []olog.KeyValue {
-	logAttrs := make([]olog.KeyValue, len(attrs))
-	for i, attr := range attrs {
-		logAttrs[i] = olog.KeyValueFromAttribute(attr)
+	return appendLogKeyValues(make([]olog.KeyValue, 0, len(attrs)), attrs)
+}
+
+// appendLogKeyValues appends attrs to dst converted to log.KeyValue.
+func appendLogKeyValues(dst []olog.KeyValue, attrs []attribute.KeyValue) []olog.KeyValue {
+	for _, attr := range attrs {
+		dst = append(dst, olog.KeyValueFromAttribute(attr))
 	}
-	return logAttrs
+	return dst
 }
 
 // Version is the current release version of Proofwatch
 func Version() string {
 	return "0.1.0"
 }
+
+// eventTime returns the evidence timestamp. Evidence without a valid timestamp
+// is rejected in strict mode and otherwise defaults to the current time, which
+// is counted in evidence_timestamp_defaulted_count.
+func (w *ProofWatch) eventTime(ctx context.Context, evidence Evidence) (time.Time, error) {
+	timestamp := evidence.Timestamp()
+	if !timestamp.IsZero() {
+		return timestamp, nil
+	}
+	if w.strictTimestamps {
+		return time.Time{}, ErrInvalidTimestamp
+	}
+	w.observer.TimestampDefaulted(ctx)
+	return time.Now(), nil
+}
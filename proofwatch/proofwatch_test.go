@@ -5,15 +5,19 @@ import (
 	"testing"
 	"time"
 
+	ocsf "github.com/Santiago-Labs/go-ocsf/ocsf/v1_5_0"
+	"github.com/ossf/gemara/layer4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel/attribute"
 	olog "go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/log/noop"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
 )
 
 // proofWatchTestFixture provides test infrastructure for ProofWatch behavioral tests
@@ -116,6 +120,175 @@ func TestNewProofWatch(t *testing.T) {
 	})
 }
 
+func TestProofWatchLogTimestamps(t *testing.T) {
+	recorder := &recordingLoggerProvider{}
+	pw, err := NewProofWatch(
+		WithLoggerProvider(recorder),
+		WithMeterProvider(sdkmetric.NewMeterProvider()),
+		WithTracerProvider(sdktrace.NewTracerProvider()),
+	)
+	require.NoError(t, err)
+
+	eventTime := time.Date(2025, 3, 14, 9, 26, 53, 0, time.UTC)
+	evidence := createTestEvidence()
+	evidence.Time = eventTime.UnixMilli()
+
+	before := time.Now()
+	require.NoError(t, pw.Log(context.Background(), evidence))
+	after := time.Now()
+
+	records := recorder.recordedLogs()
+	require.Len(t, records, 1)
+	assert.True(t, eventTime.Equal(records[0].Timestamp()), "event time should come from the evidence")
+	observed := records[0].ObservedTimestamp()
+	assert.False(t, observed.Before(before) || observed.After(after), "observed time should be the time of logging")
+}
+
+func TestProofWatchLogInvalidTimestamps(t *testing.T) {
+	validTime := time.Date(2025, 3, 14, 9, 26, 53, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		evidence    Evidence
+		strict      bool
+		expectErr   bool
+		expectedNow bool
+	}{
+		{
+			name:     "valid timestamp",
+			evidence: GemaraEvidence{AssessmentLog: layer4.AssessmentLog{End: layer4.Datetime(validTime.Format(time.RFC3339))}},
+		},
+		{
+			name:        "zero ocsf timestamp defaults to now",
+			evidence:    OCSFEvidence{},
+			expectedNow: true,
+		},
+		{
+			name:        "unparseable gemara timestamp defaults to now",
+			evidence:    GemaraEvidence{AssessmentLog: layer4.AssessmentLog{End: "not-a-time"}},
+			expectedNow: true,
+		},
+		{
+			name:      "zero ocsf timestamp rejected in strict mode",
+			evidence:  OCSFEvidence{},
+			strict:    true,
+			expectErr: true,
+		},
+		{
+			name:      "unparseable gemara timestamp rejected in strict mode",
+			evidence:  GemaraEvidence{AssessmentLog: layer4.AssessmentLog{End: "not-a-time"}},
+			strict:    true,
+			expectErr: true,
+		},
+		{
+			name:     "valid timestamp accepted in strict mode",
+			evidence: GemaraEvidence{AssessmentLog: layer4.AssessmentLog{End: layer4.Datetime(validTime.Format(time.RFC3339))}},
+			strict:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &recordingLoggerProvider{}
+			reader := sdkmetric.NewManualReader()
+			opts := []OptionFunc{
+				WithLoggerProvider(recorder),
+				WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+				WithTracerProvider(sdktrace.NewTracerProvider()),
+			}
+			if tt.strict {
+				opts = append(opts, WithStrictTimestamps())
+			}
+			pw, err := NewProofWatch(opts...)
+			require.NoError(t, err)
+
+			err = pw.Log(context.Background(), tt.evidence)
+			records := recorder.recordedLogs()
+			if tt.expectErr {
+				assert.ErrorIs(t, err, ErrInvalidTimestamp)
+				assert.Empty(t, records)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, records, 1)
+			defaulted := counterValue(t, reader, "evidence_timestamp_defaulted_count")
+			if tt.expectedNow {
+				assert.WithinDuration(t, time.Now(), records[0].Timestamp(), time.Second)
+				assert.Equal(t, int64(1), defaulted, "defaulted timestamps should be counted")
+			} else {
+				assert.True(t, validTime.Equal(records[0].Timestamp()))
+				assert.Zero(t, defaulted)
+			}
+		})
+	}
+}
+
+// counterValue returns the total of the int64 counter with the given name,
+// or zero when it has not been recorded.
+func counterValue(t *testing.T, reader *sdkmetric.ManualReader, name string) int64 {
+	t.Helper()
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	var total int64
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != name {
+				continue
+			}
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			require.True(t, ok, "%s should be an int64 sum", name)
+			for _, point := range sum.DataPoints {
+				total += point.Value
+			}
+		}
+	}
+	return total
+}
+
+func TestProofWatchLogRateLimit(t *testing.T) {
+	const (
+		records   = 5
+		perSecond = 20.0
+	)
+	recorder := &recordingLoggerProvider{}
+	pw, err := NewProofWatch(
+		WithLoggerProvider(recorder),
+		WithMeterProvider(sdkmetric.NewMeterProvider()),
+		WithTracerProvider(sdktrace.NewTracerProvider()),
+		WithRateLimit(perSecond),
+	)
+	require.NoError(t, err)
+
+	start := time.Now()
+	for i := 0; i < records; i++ {
+		require.NoError(t, pw.Log(context.Background(), createTestEvidence()))
+	}
+	elapsed := time.Since(start)
+
+	// The first record is emitted immediately; each following one waits for a token.
+	minimum := time.Duration(float64(records-1) / perSecond * float64(time.Second))
+	assert.GreaterOrEqual(t, elapsed, minimum-10*time.Millisecond)
+	assert.Len(t, recorder.recordedLogs(), records)
+}
+
+func TestProofWatchLogRateLimitCanceled(t *testing.T) {
+	recorder := &recordingLoggerProvider{}
+	pw, err := NewProofWatch(
+		WithLoggerProvider(recorder),
+		WithMeterProvider(sdkmetric.NewMeterProvider()),
+		WithTracerProvider(sdktrace.NewTracerProvider()),
+		WithRateLimit(0.1),
+	)
+	require.NoError(t, err)
+	require.NoError(t, pw.Log(context.Background(), createTestEvidence()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err = pw.Log(ctx, createTestEvidence())
+	assert.Error(t, err, "waiting for the next token should respect the context")
+	assert.Len(t, recorder.recordedLogs(), 1)
+}
+
 func TestProofWatchLog(t *testing.T) {
 	t.Run("log with default severity", func(t *testing.T) {
 		fixture := setupProofWatchTest(t)
@@ -129,6 +302,37 @@ func TestProofWatchLog(t *testing.T) {
 		fixture.collectMetrics(ctx)
 	})
 
+	t.Run("log accumulates decision counts", func(t *testing.T) {
+		fixture := setupProofWatchTest(t)
+		ctx := context.Background()
+
+		for i := 0; i < 3; i++ {
+			require.NoError(t, fixture.pw.Log(ctx, createTestEvidence()))
+		}
+
+		rm := fixture.collectMetrics(ctx)
+		var found bool
+		for _, sm := range rm.ScopeMetrics {
+			for _, m := range sm.Metrics {
+				if m.Name != "evidence_decision_count" {
+					continue
+				}
+				sum, ok := m.Data.(metricdata.Sum[int64])
+				require.True(t, ok)
+				require.Len(t, sum.DataPoints, 1)
+
+				dp := sum.DataPoints[0]
+				decision, _ := dp.Attributes.Value("decision")
+				source, _ := dp.Attributes.Value("source")
+				assert.Equal(t, "Passed", decision.AsString())
+				assert.Equal(t, "test-product", source.AsString())
+				assert.Equal(t, int64(3), dp.Value)
+				found = true
+			}
+		}
+		assert.True(t, found, "expected decision metric to be present")
+	})
+
 	t.Run("log with invalid evidence", func(t *testing.T) {
 		pw, err := NewProofWatch()
 		require.NoError(t, err)
@@ -195,6 +399,228 @@ func TestProofWatchLogWithSeverity(t *testing.T) {
 	}
 }
 
+func TestProofWatchLogCollectorName(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          []OptionFunc
+		expectedName  string
+		expectPresent bool
+	}{
+		{
+			name:          "named instance emits its name",
+			opts:          []OptionFunc{WithName("proofwatch-ci")},
+			expectedName:  "proofwatch-ci",
+			expectPresent: true,
+		},
+		{
+			name: "unnamed instance omits the attribute",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &recordingLoggerProvider{}
+			opts := append([]OptionFunc{
+				WithLoggerProvider(recorder),
+				WithMeterProvider(sdkmetric.NewMeterProvider()),
+				WithTracerProvider(sdktrace.NewTracerProvider()),
+			}, tt.opts...)
+			pw, err := NewProofWatch(opts...)
+			require.NoError(t, err)
+
+			require.NoError(t, pw.Log(context.Background(), createTestEvidence()))
+
+			records := recorder.recordedLogs()
+			require.Len(t, records, 1)
+			var value string
+			var found bool
+			records[0].WalkAttributes(func(kv olog.KeyValue) bool {
+				if kv.Key == EVIDENCE_COLLECTOR_NAME {
+					value = kv.Value.AsString()
+					found = true
+				}
+				return true
+			})
+			assert.Equal(t, tt.expectPresent, found)
+			assert.Equal(t, tt.expectedName, value)
+		})
+	}
+}
+
+func TestProofWatchLogMultiplePolicies(t *testing.T) {
+	secondPolicy := "second-policy"
+	evidence := createTestEvidence()
+	evidence.Policies = []ocsf.Policy{{Uid: &secondPolicy, Name: &secondPolicy}}
+
+	recorder := &recordingLoggerProvider{}
+	exporter := tracetest.NewInMemoryExporter()
+	pw, err := NewProofWatch(
+		WithLoggerProvider(recorder),
+		WithMeterProvider(sdkmetric.NewMeterProvider()),
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, pw.Log(context.Background(), evidence))
+
+	records := recorder.recordedLogs()
+	require.Len(t, records, 2)
+	var ruleIDs []string
+	for _, record := range records {
+		record.WalkAttributes(func(kv olog.KeyValue) bool {
+			if kv.Key == POLICY_RULE_ID {
+				ruleIDs = append(ruleIDs, kv.Value.AsString())
+			}
+			return true
+		})
+		assert.NotContains(t, record.Body().AsString(), `"policies"`)
+	}
+	assert.Equal(t, []string{"test-policy", "second-policy"}, ruleIDs)
+	assert.Len(t, exporter.GetSpans(), 2)
+}
+
+func TestProofWatchLogMissingStatus(t *testing.T) {
+	missingStatus := createTestEvidence()
+	missingStatus.Status = nil
+
+	tests := []struct {
+		name           string
+		opts           []OptionFunc
+		evidence       Evidence
+		expectErr      bool
+		expectedResult string
+	}{
+		{
+			name:           "missing status is unknown by default",
+			evidence:       missingStatus,
+			expectedResult: "Unknown",
+		},
+		{
+			name:           "missing status defaults to not run",
+			opts:           []OptionFunc{WithDefaultResult("Not Run")},
+			evidence:       missingStatus,
+			expectedResult: "Not Run",
+		},
+		{
+			name:           "default result does not replace a status",
+			opts:           []OptionFunc{WithDefaultResult("Not Run")},
+			evidence:       createTestEvidence(),
+			expectedResult: "Passed",
+		},
+		{
+			name:      "missing status rejected in strict mode",
+			opts:      []OptionFunc{WithStrictStatus(), WithDefaultResult("Not Run")},
+			evidence:  missingStatus,
+			expectErr: true,
+		},
+		{
+			name:           "status accepted in strict mode",
+			opts:           []OptionFunc{WithStrictStatus()},
+			evidence:       createTestEvidence(),
+			expectedResult: "Passed",
+		},
+		{
+			name:           "gemara evidence is not checked",
+			opts:           []OptionFunc{WithStrictStatus()},
+			evidence:       createTestGemaraEvidence(),
+			expectedResult: "Passed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &recordingLoggerProvider{}
+			opts := append([]OptionFunc{
+				WithLoggerProvider(recorder),
+				WithMeterProvider(sdkmetric.NewMeterProvider()),
+				WithTracerProvider(sdktrace.NewTracerProvider()),
+			}, tt.opts...)
+			pw, err := NewProofWatch(opts...)
+			require.NoError(t, err)
+
+			err = pw.Log(context.Background(), tt.evidence)
+			records := recorder.recordedLogs()
+			if tt.expectErr {
+				assert.ErrorIs(t, err, ErrMissingStatus)
+				assert.Empty(t, records)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, records, 1)
+			var results []string
+			records[0].WalkAttributes(func(kv olog.KeyValue) bool {
+				if kv.Key == POLICY_EVALUATION_RESULT {
+					results = append(results, kv.Value.AsString())
+				}
+				return true
+			})
+			assert.Equal(t, []string{tt.expectedResult}, results)
+		})
+	}
+}
+
+// retainingLogger keeps emitted records without cloning them, so a record
+// that shared memory with a pooled buffer would change when the buffer is
+// reused.
+type retainingLogger struct {
+	noop.Logger
+	records []olog.Record
+}
+
+func (l *retainingLogger) Emit(_ context.Context, record olog.Record) {
+	l.records = append(l.records, record)
+}
+
+type retainingLoggerProvider struct {
+	noop.LoggerProvider
+	logger *retainingLogger
+}
+
+func (p retainingLoggerProvider) Logger(string, ...olog.LoggerOption) olog.Logger {
+	return p.logger
+}
+
+func TestProofWatchLogDoesNotAliasBuffers(t *testing.T) {
+	logger := &retainingLogger{}
+	exporter := tracetest.NewInMemoryExporter()
+	pw, err := NewProofWatch(
+		WithLoggerProvider(retainingLoggerProvider{logger: logger}),
+		WithMeterProvider(sdkmetric.NewMeterProvider()),
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))),
+		WithName("proofwatch-ci"),
+	)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	policies := []string{"first-policy", "second-policy", "third-policy"}
+	for _, policy := range policies {
+		evidence := createTestEvidence()
+		evidence.Policy.Uid = &policy
+		require.NoError(t, pw.Log(ctx, evidence))
+	}
+
+	require.Len(t, logger.records, len(policies))
+	spans := exporter.GetSpans()
+	require.Len(t, spans, len(policies))
+	for i, policy := range policies {
+		var ruleId, collector string
+		logger.records[i].WalkAttributes(func(kv olog.KeyValue) bool {
+			switch kv.Key {
+			case POLICY_RULE_ID:
+				ruleId = kv.Value.AsString()
+			case EVIDENCE_COLLECTOR_NAME:
+				collector = kv.Value.AsString()
+			}
+			return true
+		})
+		assert.Equal(t, policy, ruleId, "record %d", i)
+		assert.Equal(t, "proofwatch-ci", collector, "record %d", i)
+
+		require.Len(t, spans[i].Events, 1)
+		assert.Contains(t, spans[i].Events[0].Attributes, attribute.String(POLICY_RULE_ID, policy), "span %d", i)
+	}
+}
+
 func TestVersion(t *testing.T) {
 	version := Version()
 	assert.NotEmpty(t, version)
@@ -234,3 +660,37 @@ func (e *invalidEvidence) Attributes() []attribute.KeyValue {
 func (e *invalidEvidence) Timestamp() time.Time {
 	return time.Now()
 }
+
+// BenchmarkProofWatchLog measures the allocations proofwatch makes to emit
+// one record, with no-op providers so only the attribute-building path and
+// serialization are counted.
+func BenchmarkProofWatchLog(b *testing.B) {
+	benchmarks := []struct {
+		name     string
+		opts     []OptionFunc
+		evidence Evidence
+	}{
+		{name: "ocsf", evidence: createTestEvidence()},
+		{name: "gemara", evidence: createTestGemaraEvidence()},
+		{name: "ocsf with collector name", opts: []OptionFunc{WithName("proofwatch-ci")}, evidence: createTestEvidence()},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			pw, err := NewProofWatch(append([]OptionFunc{
+				WithLoggerProvider(noop.NewLoggerProvider()),
+				WithMeterProvider(metricnoop.NewMeterProvider()),
+				WithTracerProvider(tracenoop.NewTracerProvider()),
+			}, bm.opts...)...)
+			require.NoError(b, err)
+
+			ctx := context.Background()
+			b.ReportAllocs()
+			for b.Loop() {
+				if err := pw.Log(ctx, bm.evidence); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package proofwatch
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// DefaultMaxEvidenceBytes is the default limit for a single evidence payload
+// accepted by the Receiver.
+const DefaultMaxEvidenceBytes int64 = 1 << 20
+
+// Receiver is an http.Handler that accepts serialized evidence over HTTP and
+// logs it through ProofWatch. This allows external scanners to push evidence
+// without embedding the library.
+//
+// Evidence is submitted with `POST /v1/evidence/{kind}`, where kind selects
+// the decoder from the Registry (e.g. "ocsf", "gemara", or "vulnerability").
+type Receiver struct {
+	pw       *ProofWatch
+	registry Registry
+	mux      *http.ServeMux
+}
+
+// NewReceiver creates a Receiver that decodes evidence using the given registry.
+// If registry is nil, the DefaultRegistry is used.
+func NewReceiver(pw *ProofWatch, registry Registry) *Receiver {
+	if registry == nil {
+		registry = DefaultRegistry()
+	}
+	r := &Receiver{
+		pw:       pw,
+		registry: registry,
+		mux:      http.NewServeMux(),
+	}
+	r.mux.HandleFunc("POST /v1/evidence/{kind}", r.handleEvidence)
+	return r
+}
+
+// ServeHTTP implements http.Handler.
+func (r *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mux.ServeHTTP(w, req)
+}
+
+func (r *Receiver) handleEvidence(w http.ResponseWriter, req *http.Request) {
+	kind := req.PathValue("kind")
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, DefaultMaxEvidenceBytes))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			http.Error(w, "evidence payload too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to read evidence: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	evidence, err := r.pw.decode(r.registry, kind, body)
+	if err != nil {
+		if errors.Is(err, ErrUnknownKind) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := r.pw.Log(req.Context(), evidence); err != nil {
+		if errors.Is(err, ErrInvalidTimestamp) || errors.Is(err, ErrMissingStatus) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to log evidence: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
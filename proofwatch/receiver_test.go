@@ -0,0 +1,181 @@
+package proofwatch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	olog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/noop"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// recordingLoggerProvider captures every emitted log record for assertions.
+type recordingLoggerProvider struct {
+	noop.LoggerProvider
+	mu      sync.Mutex
+	records []olog.Record
+}
+
+func (p *recordingLoggerProvider) Logger(string, ...olog.LoggerOption) olog.Logger {
+	return &recordingLogger{provider: p}
+}
+
+// recordedLogs returns a snapshot of the records emitted so far.
+func (p *recordingLoggerProvider) recordedLogs() []olog.Record {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]olog.Record(nil), p.records...)
+}
+
+type recordingLogger struct {
+	noop.Logger
+	provider *recordingLoggerProvider
+}
+
+func (l *recordingLogger) Emit(_ context.Context, record olog.Record) {
+	l.provider.mu.Lock()
+	defer l.provider.mu.Unlock()
+	l.provider.records = append(l.provider.records, record.Clone())
+}
+
+// setupReceiverTest starts a Receiver backed by a recording logger provider.
+func setupReceiverTest(t *testing.T, opts ...OptionFunc) (*httptest.Server, *recordingLoggerProvider) {
+	recorder := &recordingLoggerProvider{}
+	pw, err := NewProofWatch(append([]OptionFunc{
+		WithLoggerProvider(recorder),
+		WithMeterProvider(sdkmetric.NewMeterProvider()),
+		WithTracerProvider(sdktrace.NewTracerProvider()),
+	}, opts...)...)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(NewReceiver(pw, nil))
+	t.Cleanup(server.Close)
+	return server, recorder
+}
+
+func TestReceiverIngestsEvidence(t *testing.T) {
+	tests := []struct {
+		name         string
+		kind         string
+		evidence     Evidence
+		expectedRule string
+	}{
+		{
+			name:         "ocsf evidence",
+			kind:         KindOCSF,
+			evidence:     createTestEvidence(),
+			expectedRule: "test-policy",
+		},
+		{
+			name:         "gemara evidence",
+			kind:         KindGemara,
+			evidence:     createTestGemaraEvidence(),
+			expectedRule: "test-procedure-id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, recorder := setupReceiverTest(t)
+
+			payload, err := tt.evidence.ToJSON()
+			require.NoError(t, err)
+
+			resp, err := http.Post(server.URL+"/v1/evidence/"+tt.kind, "application/json", bytes.NewReader(payload))
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+			records := recorder.recordedLogs()
+			require.Len(t, records, 1)
+
+			var ruleID string
+			records[0].WalkAttributes(func(attr olog.KeyValue) bool {
+				if attr.Key == POLICY_RULE_ID {
+					ruleID = attr.Value.AsString()
+				}
+				return true
+			})
+			assert.Equal(t, tt.expectedRule, ruleID)
+			assert.Equal(t, olog.KindString, records[0].Body().Kind())
+			assert.True(t, json.Valid([]byte(records[0].Body().AsString())))
+		})
+	}
+}
+
+func TestReceiverRejectsInvalidRequests(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		opts           []OptionFunc
+		expectedStatus int
+	}{
+		{
+			name:           "unknown evidence kind",
+			method:         http.MethodPost,
+			path:           "/v1/evidence/unknown",
+			body:           `{}`,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "malformed payload",
+			method:         http.MethodPost,
+			path:           "/v1/evidence/ocsf",
+			body:           `{"policy":`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "missing status in strict mode",
+			method:         http.MethodPost,
+			path:           "/v1/evidence/ocsf",
+			body:           `{"time": 1741944413000, "policy": {"uid": "ocsf-policy"}, "metadata": {"product": {"name": "opa"}}}`,
+			opts:           []OptionFunc{WithStrictStatus()},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "wrong method",
+			method:         http.MethodGet,
+			path:           "/v1/evidence/ocsf",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, recorder := setupReceiverTest(t, tt.opts...)
+
+			req, err := http.NewRequest(tt.method, server.URL+tt.path, bytes.NewBufferString(tt.body))
+			require.NoError(t, err)
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			assert.Empty(t, recorder.recordedLogs())
+		})
+	}
+}
+
+func TestRegistryDecode(t *testing.T) {
+	registry := DefaultRegistry()
+
+	_, err := registry.Decode("unknown", []byte(`{}`))
+	assert.ErrorIs(t, err, ErrUnknownKind)
+
+	registry.Register("custom", func(data []byte) (Evidence, error) {
+		return createTestEvidence(), nil
+	})
+	evidence, err := registry.Decode("custom", nil)
+	require.NoError(t, err)
+	assert.IsType(t, OCSFEvidence{}, evidence)
+}
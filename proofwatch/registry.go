@@ -0,0 +1,58 @@
+package proofwatch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const (
+	// KindOCSF identifies evidence serialized as OCSFEvidence.
+	KindOCSF = "ocsf"
+	// KindGemara identifies evidence serialized as GemaraEvidence.
+	KindGemara = "gemara"
+	// KindVulnerability identifies evidence serialized as VulnerabilityEvidence.
+	KindVulnerability = "vulnerability"
+)
+
+// ErrUnknownKind is returned when no decoder is registered for an evidence kind.
+var ErrUnknownKind = errors.New("unknown evidence kind")
+
+// DecodeFunc decodes a serialized evidence payload into Evidence.
+type DecodeFunc func(data []byte) (Evidence, error)
+
+// Registry maps an evidence kind to the decoder for its serialized form.
+type Registry map[string]DecodeFunc
+
+// DefaultRegistry returns a Registry with decoders for the evidence types
+// provided by this package.
+func DefaultRegistry() Registry {
+	return Registry{
+		KindOCSF:          decodeJSON[OCSFEvidence],
+		KindGemara:        decodeJSON[GemaraEvidence],
+		KindVulnerability: decodeJSON[VulnerabilityEvidence],
+	}
+}
+
+// Register adds or replaces the decoder for the given evidence kind.
+func (r Registry) Register(kind string, decode DecodeFunc) {
+	r[kind] = decode
+}
+
+// Decode decodes the payload using the decoder registered for kind.
+func (r Registry) Decode(kind string, data []byte) (Evidence, error) {
+	decode, ok := r[kind]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKind, kind)
+	}
+	return decode(data)
+}
+
+// decodeJSON unmarshals a JSON payload into the evidence type T.
+func decodeJSON[T Evidence](data []byte) (Evidence, error) {
+	var evidence T
+	if err := json.Unmarshal(data, &evidence); err != nil {
+		return nil, fmt.Errorf("failed to decode evidence: %w", err)
+	}
+	return evidence, nil
+}
@@ -0,0 +1,42 @@
+package proofwatch
+
+import (
+	"math"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// sampler decides which evidence records are emitted when sampling is
+// enabled. Sampling is deterministic: of every n records subject to sampling,
+// rate*n are kept, spread evenly, so the emitted volume is predictable.
+type sampler struct {
+	rate               float64
+	alwaysKeepFailures bool
+	seen               atomic.Uint64
+}
+
+func newSampler(rate float64, alwaysKeepFailures bool) *sampler {
+	return &sampler{
+		rate:               math.Max(0, math.Min(1, rate)),
+		alwaysKeepFailures: alwaysKeepFailures,
+	}
+}
+
+// keep reports whether the record with attrs should be emitted.
+func (s *sampler) keep(attrs []attribute.KeyValue) bool {
+	if s.alwaysKeepFailures && isFailure(attrs) {
+		return true
+	}
+	n := float64(s.seen.Add(1))
+	// Keep the record whenever the running total of kept records at this
+	// rate crosses the next whole number.
+	return math.Floor(n*s.rate) > math.Floor((n-1)*s.rate)
+}
+
+// isFailure reports whether the attributes describe a failed evaluation or a
+// blocked action.
+func isFailure(attrs []attribute.KeyValue) bool {
+	return attributeValue(attrs, POLICY_EVALUATION_RESULT) == "Failed" ||
+		attributeValue(attrs, COMPLIANCE_REMEDIATION_ACTION) == "Block"
+}
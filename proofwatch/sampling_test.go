@@ -0,0 +1,121 @@
+package proofwatch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	olog "go.opentelemetry.io/otel/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestProofWatchLogSampling(t *testing.T) {
+	tests := []struct {
+		name             string
+		rate             float64
+		keepFailures     bool
+		expectedPassed   int
+		expectedFailures int
+	}{
+		{
+			name:             "failures bypass sampling",
+			rate:             0.25,
+			keepFailures:     true,
+			expectedPassed:   25,
+			expectedFailures: 20,
+		},
+		{
+			name:             "failures are sampled without the override",
+			rate:             0.25,
+			expectedPassed:   25,
+			expectedFailures: 5,
+		},
+		{
+			name:             "zero rate keeps only failures",
+			rate:             0,
+			keepFailures:     true,
+			expectedFailures: 20,
+		},
+		{
+			name:             "full rate keeps everything",
+			rate:             1,
+			expectedPassed:   100,
+			expectedFailures: 20,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &recordingLoggerProvider{}
+			pw, err := NewProofWatch(
+				WithLoggerProvider(recorder),
+				WithMeterProvider(sdkmetric.NewMeterProvider()),
+				WithTracerProvider(sdktrace.NewTracerProvider()),
+				WithSampling(tt.rate, tt.keepFailures),
+			)
+			require.NoError(t, err)
+
+			ctx := context.Background()
+			for i := 0; i < 100; i++ {
+				require.NoError(t, pw.Log(ctx, createTestEvidence()))
+			}
+			for i := 0; i < 20; i++ {
+				require.NoError(t, pw.Log(ctx, createFailedTestEvidence()))
+			}
+
+			results := make(map[string]int)
+			for _, record := range recorder.recordedLogs() {
+				record.WalkAttributes(func(kv olog.KeyValue) bool {
+					if kv.Key == POLICY_EVALUATION_RESULT {
+						results[kv.Value.AsString()]++
+					}
+					return true
+				})
+			}
+			assert.Equal(t, tt.expectedPassed, results["Passed"])
+			assert.Equal(t, tt.expectedFailures, results["Failed"])
+		})
+	}
+}
+
+func TestIsFailure(t *testing.T) {
+	tests := []struct {
+		name     string
+		attrs    []attribute.KeyValue
+		expected bool
+	}{
+		{
+			name:     "failed evaluation",
+			attrs:    []attribute.KeyValue{attribute.String(POLICY_EVALUATION_RESULT, "Failed")},
+			expected: true,
+		},
+		{
+			name: "blocked action",
+			attrs: []attribute.KeyValue{
+				attribute.String(POLICY_EVALUATION_RESULT, "Passed"),
+				attribute.String(COMPLIANCE_REMEDIATION_ACTION, "Block"),
+			},
+			expected: true,
+		},
+		{
+			name:  "passed evaluation",
+			attrs: []attribute.KeyValue{attribute.String(POLICY_EVALUATION_RESULT, "Passed")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isFailure(tt.attrs))
+		})
+	}
+}
+
+func createFailedTestEvidence() OCSFEvidence {
+	evidence := createTestEvidence()
+	status := "failure"
+	evidence.Status = &status
+	return evidence
+}
@@ -0,0 +1,86 @@
+package proofwatch
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// ErrSchemaValidation is returned when schema validation is enabled and an
+// evidence payload does not match the schema for its kind.
+var ErrSchemaValidation = errors.New("evidence does not match schema")
+
+//go:embed schemas/*.json
+var schemaFiles embed.FS
+
+// schemaFileByKind names the embedded schema for each evidence kind that has
+// one. Payloads of other kinds are not validated.
+var schemaFileByKind = map[string]string{
+	KindOCSF:   "schemas/ocsf.json",
+	KindGemara: "schemas/gemara.json",
+}
+
+// evidenceSchemas compiles the embedded schemas once, on first use.
+var evidenceSchemas = sync.OnceValues(func() (map[string]*jsonschema.Schema, error) {
+	compiler := jsonschema.NewCompiler()
+	schemas := make(map[string]*jsonschema.Schema, len(schemaFileByKind))
+	for kind, file := range schemaFileByKind {
+		data, err := schemaFiles.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+		if err := compiler.AddResource(file, doc); err != nil {
+			return nil, err
+		}
+		schema, err := compiler.Compile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile %s: %w", file, err)
+		}
+		schemas[kind] = schema
+	}
+	return schemas, nil
+})
+
+// ValidateSchema checks a serialized evidence payload against the schema for
+// its kind and describes every violation in the returned error, which wraps
+// ErrSchemaValidation. Kinds without a schema are accepted as is.
+func ValidateSchema(kind string, data []byte) error {
+	schemas, err := evidenceSchemas()
+	if err != nil {
+		return err
+	}
+	schema, ok := schemas[kind]
+	if !ok {
+		return nil
+	}
+
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("%w: invalid JSON: %v", ErrSchemaValidation, err)
+	}
+	if err := schema.Validate(instance); err != nil {
+		return fmt.Errorf("%w: %v", ErrSchemaValidation, err)
+	}
+	return nil
+}
+
+// decode decodes the payload with the registry, validating it against the
+// schema for its kind first when schema validation is enabled.
+func (w *ProofWatch) decode(registry Registry, kind string, data []byte) (Evidence, error) {
+	if w.validateSchemas {
+		if _, ok := registry[kind]; ok {
+			if err := ValidateSchema(kind, data); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return registry.Decode(kind, data)
+}
@@ -0,0 +1,127 @@
+package proofwatch
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	validOCSFPayload   = `{"time": 1741944413000, "status": "success", "policy": {"uid": "ocsf-policy"}, "metadata": {"product": {"name": "opa"}}}`
+	invalidOCSFPayload = `{"time": "yesterday", "status": "success", "policy": {"uid": 42}}`
+)
+
+func TestValidateSchema(t *testing.T) {
+	tests := []struct {
+		name          string
+		kind          string
+		payload       string
+		expectedError []string
+	}{
+		{
+			name:    "valid ocsf evidence",
+			kind:    KindOCSF,
+			payload: validOCSFPayload,
+		},
+		{
+			name:          "structurally invalid ocsf evidence",
+			kind:          KindOCSF,
+			payload:       invalidOCSFPayload,
+			expectedError: []string{"metadata", "/time", "/policy/uid"},
+		},
+		{
+			name:    "valid gemara evidence",
+			kind:    KindGemara,
+			payload: `{"requirement": {"reference-id": "OSPS-B", "entry-id": "AC-1"}, "result": "Passed", "end": "2025-01-15T10:30:00Z"}`,
+		},
+		{
+			name:          "gemara evidence with an unknown result",
+			kind:          KindGemara,
+			payload:       `{"requirement": {"reference-id": "OSPS-B", "entry-id": "AC-1"}, "result": "Maybe"}`,
+			expectedError: []string{"/result"},
+		},
+		{
+			name:          "malformed json",
+			kind:          KindOCSF,
+			payload:       `{not json}`,
+			expectedError: []string{"invalid JSON"},
+		},
+		{
+			name:    "kinds without a schema are not validated",
+			kind:    KindVulnerability,
+			payload: `{"Severity": 3}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSchema(tt.kind, []byte(tt.payload))
+			if tt.expectedError == nil {
+				assert.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrSchemaValidation)
+			for _, expected := range tt.expectedError {
+				assert.Contains(t, err.Error(), expected)
+			}
+		})
+	}
+}
+
+func TestReceiverSchemaValidation(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         []OptionFunc
+		payload      string
+		expectedCode int
+	}{
+		{
+			name:         "valid payload is accepted",
+			opts:         []OptionFunc{WithSchemaValidation()},
+			payload:      validOCSFPayload,
+			expectedCode: http.StatusAccepted,
+		},
+		{
+			name:         "invalid payload is rejected",
+			opts:         []OptionFunc{WithSchemaValidation()},
+			payload:      invalidOCSFPayload,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "invalid payload is decoded without validation",
+			payload:      `{"time": 1741944413000, "policy": {"name": "no metadata"}}`,
+			expectedCode: http.StatusAccepted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &recordingLoggerProvider{}
+			opts := append([]OptionFunc{
+				WithLoggerProvider(recorder),
+				WithMeterProvider(sdkmetric.NewMeterProvider()),
+				WithTracerProvider(sdktrace.NewTracerProvider()),
+			}, tt.opts...)
+			pw, err := NewProofWatch(opts...)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/evidence/ocsf", strings.NewReader(tt.payload))
+			w := httptest.NewRecorder()
+			NewReceiver(pw, nil).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code, w.Body.String())
+			if tt.expectedCode == http.StatusAccepted {
+				assert.Len(t, recorder.recordedLogs(), 1)
+			} else {
+				assert.Empty(t, recorder.recordedLogs())
+				assert.Contains(t, w.Body.String(), ErrSchemaValidation.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package proofwatch
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var _ Evidence = (*VulnerabilityEvidence)(nil)
+
+// VulnerabilityEvidence represents a single finding from a vulnerability scanner such as Trivy.
+// Scanners grade findings by severity rather than pass/fail, so the severity drives both the
+// policy evaluation result and the compliance risk level. Field names follow the Trivy JSON report.
+type VulnerabilityEvidence struct {
+	Scanner          string    `json:"Scanner"`
+	ScannerVersion   string    `json:"ScannerVersion,omitempty"`
+	ArtifactName     string    `json:"ArtifactName,omitempty"`
+	ArtifactType     string    `json:"ArtifactType,omitempty"`
+	VulnerabilityID  string    `json:"VulnerabilityID"`
+	Title            string    `json:"Title,omitempty"`
+	Severity         string    `json:"Severity"`
+	PkgName          string    `json:"PkgName,omitempty"`
+	InstalledVersion string    `json:"InstalledVersion,omitempty"`
+	FixedVersion     string    `json:"FixedVersion,omitempty"`
+	CreatedAt        time.Time `json:"CreatedAt"`
+}
+
+func (v VulnerabilityEvidence) ToJSON() ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (v VulnerabilityEvidence) Attributes() []attribute.KeyValue {
+	scanner := v.Scanner
+	if scanner == "" {
+		scanner = "unknown_source"
+	}
+
+	attrs := []attribute.KeyValue{
+		attribute.String(POLICY_ENGINE_NAME, scanner),
+		attribute.String(POLICY_RULE_ID, v.VulnerabilityID),
+		attribute.String(POLICY_EVALUATION_RESULT, mapSeverityResult(v.Severity)),
+	}
+
+	if v.ScannerVersion != "" {
+		attrs = append(attrs, attribute.String(POLICY_ENGINE_VERSION, v.ScannerVersion))
+	}
+	if v.Title != "" {
+		attrs = append(attrs, attribute.String(POLICY_RULE_NAME, v.Title))
+	}
+	if level, ok := mapSeverityRiskLevel(v.Severity); ok {
+		attrs = append(attrs, attribute.String(COMPLIANCE_RISK_LEVEL, level))
+	}
+	if v.PkgName != "" {
+		attrs = append(attrs, attribute.String(POLICY_EVALUATION_MESSAGE,
+			fmt.Sprintf("%s %s is affected by %s", v.PkgName, v.InstalledVersion, v.VulnerabilityID)))
+		if v.FixedVersion != "" {
+			attrs = append(attrs, attribute.String(COMPLIANCE_REMEDIATION_DESCRIPTION,
+				fmt.Sprintf("Upgrade %s to %s", v.PkgName, v.FixedVersion)))
+		}
+	}
+
+	// Add target information if available
+	if v.ArtifactName != "" {
+		attrs = append(attrs, attribute.String(POLICY_TARGET_NAME, v.ArtifactName))
+	}
+	if v.ArtifactType != "" {
+		attrs = append(attrs, attribute.String(POLICY_TARGET_TYPE, v.ArtifactType))
+	}
+
+	return attrs
+}
+
+func (v VulnerabilityEvidence) Timestamp() time.Time {
+	return v.CreatedAt
+}
+
+// mapSeverityResult maps a scanner severity to a policy evaluation result.
+// Critical and high findings fail, medium findings need review, and low
+// findings pass.
+func mapSeverityResult(severity string) string {
+	switch strings.ToUpper(severity) {
+	case "CRITICAL", "HIGH":
+		return "Failed"
+	case "MEDIUM":
+		return "Needs Review"
+	case "LOW":
+		return "Passed"
+	default:
+		return "Unknown"
+	}
+}
+
+// mapSeverityRiskLevel maps a scanner severity to a compliance risk level.
+// Unknown severities have no risk level.
+func mapSeverityRiskLevel(severity string) (string, bool) {
+	switch strings.ToUpper(severity) {
+	case "CRITICAL":
+		return "Critical", true
+	case "HIGH":
+		return "High", true
+	case "MEDIUM":
+		return "Medium", true
+	case "LOW":
+		return "Low", true
+	case "NEGLIGIBLE":
+		return "Informational", true
+	default:
+		return "", false
+	}
+}
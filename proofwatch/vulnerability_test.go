@@ -0,0 +1,132 @@
+package proofwatch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVulnerabilityEvidenceSeverityMapping(t *testing.T) {
+	tests := []struct {
+		name           string
+		severity       string
+		expectedResult string
+		expectedRisk   string
+	}{
+		{
+			name:           "critical severity fails",
+			severity:       "CRITICAL",
+			expectedResult: "Failed",
+			expectedRisk:   "Critical",
+		},
+		{
+			name:           "high severity fails",
+			severity:       "HIGH",
+			expectedResult: "Failed",
+			expectedRisk:   "High",
+		},
+		{
+			name:           "medium severity needs review",
+			severity:       "MEDIUM",
+			expectedResult: "Needs Review",
+			expectedRisk:   "Medium",
+		},
+		{
+			name:           "low severity passes",
+			severity:       "LOW",
+			expectedResult: "Passed",
+			expectedRisk:   "Low",
+		},
+		{
+			name:           "negligible severity is informational",
+			severity:       "NEGLIGIBLE",
+			expectedResult: "Unknown",
+			expectedRisk:   "Informational",
+		},
+		{
+			name:           "severity is case insensitive",
+			severity:       "high",
+			expectedResult: "Failed",
+			expectedRisk:   "High",
+		},
+		{
+			name:           "unknown severity has no risk level",
+			severity:       "UNKNOWN",
+			expectedResult: "Unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evidence := VulnerabilityEvidence{
+				Scanner:         "trivy",
+				VulnerabilityID: "CVE-2024-0001",
+				Severity:        tt.severity,
+			}
+
+			attrMap := make(map[string]interface{})
+			for _, attr := range evidence.Attributes() {
+				attrMap[string(attr.Key)] = attr.Value.AsInterface()
+			}
+
+			assert.Equal(t, "trivy", attrMap[POLICY_ENGINE_NAME])
+			assert.Equal(t, "CVE-2024-0001", attrMap[POLICY_RULE_ID])
+			assert.Equal(t, tt.expectedResult, attrMap[POLICY_EVALUATION_RESULT])
+			if tt.expectedRisk == "" {
+				assert.NotContains(t, attrMap, COMPLIANCE_RISK_LEVEL)
+				return
+			}
+			assert.Equal(t, tt.expectedRisk, attrMap[COMPLIANCE_RISK_LEVEL])
+		})
+	}
+}
+
+func TestVulnerabilityEvidenceAttributes(t *testing.T) {
+	createdAt := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+	evidence := VulnerabilityEvidence{
+		Scanner:          "trivy",
+		ScannerVersion:   "0.58.0",
+		ArtifactName:     "ghcr.io/example/app:1.0",
+		ArtifactType:     "container_image",
+		VulnerabilityID:  "CVE-2024-0001",
+		Title:            "openssl: buffer overflow",
+		Severity:         "HIGH",
+		PkgName:          "openssl",
+		InstalledVersion: "3.0.1",
+		FixedVersion:     "3.0.2",
+		CreatedAt:        createdAt,
+	}
+
+	attrMap := make(map[string]interface{})
+	for _, attr := range evidence.Attributes() {
+		attrMap[string(attr.Key)] = attr.Value.AsInterface()
+	}
+
+	assert.Equal(t, "0.58.0", attrMap[POLICY_ENGINE_VERSION])
+	assert.Equal(t, "openssl: buffer overflow", attrMap[POLICY_RULE_NAME])
+	assert.Equal(t, "openssl 3.0.1 is affected by CVE-2024-0001", attrMap[POLICY_EVALUATION_MESSAGE])
+	assert.Equal(t, "Upgrade openssl to 3.0.2", attrMap[COMPLIANCE_REMEDIATION_DESCRIPTION])
+	assert.Equal(t, "ghcr.io/example/app:1.0", attrMap[POLICY_TARGET_NAME])
+	assert.Equal(t, "container_image", attrMap[POLICY_TARGET_TYPE])
+	assert.Equal(t, createdAt, evidence.Timestamp())
+}
+
+func TestVulnerabilityEvidenceDecode(t *testing.T) {
+	data := []byte(`{"Scanner": "trivy", "VulnerabilityID": "CVE-2024-0001", "Severity": "CRITICAL", "CreatedAt": "2025-01-15T10:30:00Z"}`)
+
+	evidence, err := DefaultRegistry().Decode(KindVulnerability, data)
+	require.NoError(t, err)
+
+	vuln, ok := evidence.(VulnerabilityEvidence)
+	require.True(t, ok)
+	assert.Equal(t, "CVE-2024-0001", vuln.VulnerabilityID)
+	assert.Equal(t, "CRITICAL", vuln.Severity)
+	assert.Equal(t, time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC), vuln.Timestamp())
+}
+
+func TestVulnerabilityEvidenceMissingTimestamp(t *testing.T) {
+	evidence := VulnerabilityEvidence{VulnerabilityID: "CVE-2024-0001"}
+	assert.True(t, evidence.Timestamp().IsZero())
+}
@@ -2,22 +2,189 @@ package truthbeam
 
 import (
 	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
+
+	"github.com/complytime/complybeacon/truthbeam/internal/client"
 )
 
 // Config defines configuration for the truthbeam processor.
 type Config struct {
 	ClientConfig confighttp.ClientConfig `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
+
+	// FailoverEndpoints are additional compass endpoints tried in order when
+	// the primary endpoint is unreachable or returns a 5xx response.
+	FailoverEndpoints []string `mapstructure:"failover_endpoints"`
+
+	// ForceReenrich enables enrichment of log records that already carry
+	// compliance attributes (e.g. when a pipeline routes logs through the
+	// processor more than once). By default, these records are skipped.
+	ForceReenrich bool `mapstructure:"force_reenrich"`
+
+	// HealthCheck verifies on start that compass is reachable and logs a
+	// warning if it is not. Startup does not fail either way.
+	HealthCheck bool `mapstructure:"health_check"`
+
+	// FailOnError makes the processor return enrichment errors for the batch
+	// once every record has been processed, so the pipeline can retry or
+	// dead-letter it. By default, failures are logged and the batch continues.
+	FailOnError bool `mapstructure:"fail_on_error"`
+
+	// AuditLog emits a structured log entry for every enriched record with
+	// the input policy, matched control, frameworks, and resulting status.
+	AuditLog bool `mapstructure:"audit_log"`
+
+	// EnrichmentTimeout bounds each enrichment call on top of the client
+	// timeout, so one slow lookup does not stall the rest of a batch.
+	// A zero value disables the per-call deadline.
+	EnrichmentTimeout time.Duration `mapstructure:"enrichment_timeout"`
+
+	// MaxConcurrentRequests caps the enrichment calls in flight to compass
+	// across all batches the processor is handling at once. Records beyond
+	// the limit wait for a free slot. A zero value removes the limit.
+	MaxConcurrentRequests int `mapstructure:"max_concurrent_requests"`
+
+	// CircuitBreaker short-circuits enrichment calls while compass is failing.
+	CircuitBreaker CircuitBreakerConfig `mapstructure:"circuit_breaker"`
+
+	// PrefetchRules loads the policy rules compass can map, so records for
+	// other rules are marked unmapped without a call to compass.
+	PrefetchRules PrefetchRulesConfig `mapstructure:"prefetch_rules"`
+
+	// AttributeKeyPrefix is prepended to every compliance attribute key the
+	// processor writes, e.g. "acme." writes "acme.compliance.status".
+	AttributeKeyPrefix string `mapstructure:"attribute_key_prefix"`
+
+	// AttributeKeyOverrides renames individual compliance attribute keys.
+	// Overrides are keyed by the default attribute key and take precedence
+	// over AttributeKeyPrefix.
+	AttributeKeyOverrides map[string]string `mapstructure:"attribute_key_overrides"`
+
+	// StatusVocabulary renames the compliance status values the processor
+	// writes, keyed by compass status, e.g. Compliant: PASS. Statuses without
+	// an entry are written unchanged.
+	StatusVocabulary map[string]string `mapstructure:"status_vocabulary"`
+
+	// ComplianceFormat selects how compliance data is written: "attributes"
+	// (the default) writes flat compliance.* attributes, "json_attribute"
+	// writes the enrichment response as JSON to a single compliance
+	// attribute, and "json_body" writes it next to the original log record
+	// body, which is nested under "body".
+	ComplianceFormat string `mapstructure:"compliance_format"`
+
+	// StatsInterval periodically logs rolling enrichment stats (records
+	// enriched, marked unmapped from prefetched rules, failed, and the success
+	// rate) for long-lived collectors.
+	// A zero value disables the report.
+	StatsInterval time.Duration `mapstructure:"stats_interval"`
+}
+
+// CircuitBreakerConfig configures the circuit breaker around compass requests.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failed requests that
+	// opens the circuit. Connection failures and 502, 503, and 504 responses
+	// count as failures; other error responses do not. A zero value disables
+	// the circuit breaker.
+	FailureThreshold int `mapstructure:"failure_threshold"`
+
+	// Cooldown is how long the circuit stays open before a probe request
+	// is let through.
+	Cooldown time.Duration `mapstructure:"cooldown"`
+}
+
+// PrefetchRulesConfig configures loading the policy rules compass can map.
+type PrefetchRulesConfig struct {
+	// Enabled loads the rules on start. If they cannot be loaded, or compass
+	// cannot list every rule it maps, every record is looked up as usual.
+	Enabled bool `mapstructure:"enabled"`
+
+	// RefreshInterval reloads the rules periodically, so catalogs reloaded
+	// in compass are picked up. A zero value loads them only on start.
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
 }
 
 var _ component.Config = (*Config)(nil)
 
-// Validate checks if the exporter configuration is valid
+// Validate checks the processor configuration when the collector loads it,
+// so a bad compass endpoint fails at startup rather than on the first request.
 func (cfg *Config) Validate() error {
 	if cfg.ClientConfig.Endpoint == "" {
 		return errors.New("endpoint must be specified")
 	}
+	if err := validateEndpoint(cfg.ClientConfig.Endpoint); err != nil {
+		return fmt.Errorf("invalid endpoint: %w", err)
+	}
+	for i, endpoint := range cfg.FailoverEndpoints {
+		if err := validateEndpoint(endpoint); err != nil {
+			return fmt.Errorf("invalid failover endpoint %d: %w", i, err)
+		}
+	}
+	if cfg.EnrichmentTimeout < 0 {
+		return errors.New("enrichment timeout must not be negative")
+	}
+	if cfg.StatsInterval < 0 {
+		return errors.New("stats interval must not be negative")
+	}
+	if cfg.MaxConcurrentRequests < 0 {
+		return errors.New("max concurrent requests must not be negative")
+	}
+	if cfg.PrefetchRules.RefreshInterval < 0 {
+		return errors.New("prefetch rules refresh interval must not be negative")
+	}
+	if cfg.CircuitBreaker.FailureThreshold < 0 {
+		return errors.New("circuit breaker failure threshold must not be negative")
+	}
+	if cfg.CircuitBreaker.FailureThreshold > 0 && cfg.CircuitBreaker.Cooldown <= 0 {
+		return errors.New("circuit breaker cooldown must be positive")
+	}
+	switch client.Format(cfg.ComplianceFormat) {
+	case "", client.FormatAttributes, client.FormatJSONAttribute, client.FormatJSONBody:
+	default:
+		return fmt.Errorf("unknown compliance format %q", cfg.ComplianceFormat)
+	}
+	for status := range cfg.StatusVocabulary {
+		if !slices.Contains(complianceStatuses, client.ComplianceStatus(status)) {
+			return fmt.Errorf("status vocabulary: unknown compliance status %q", status)
+		}
+	}
+	return nil
+}
+
+// complianceStatuses are the compass statuses a status vocabulary can rename.
+var complianceStatuses = []client.ComplianceStatus{
+	client.ComplianceStatusCompliant,
+	client.ComplianceStatusNonCompliant,
+	client.ComplianceStatusNotApplicable,
+	client.ComplianceStatusExempt,
+	client.ComplianceStatusNeedsReview,
+	client.ComplianceStatusUnknown,
+}
+
+// statusVocabulary returns the configured status vocabulary for the applier.
+func (cfg *Config) statusVocabulary() client.StatusVocabulary {
+	vocabulary := make(client.StatusVocabulary, len(cfg.StatusVocabulary))
+	for status, value := range cfg.StatusVocabulary {
+		vocabulary[client.ComplianceStatus(status)] = value
+	}
+	return vocabulary
+}
+
+// validateEndpoint checks that endpoint is an absolute http or https URL.
+func validateEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("%q must use http or https", endpoint)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("%q has no host", endpoint)
+	}
 	return nil
 }
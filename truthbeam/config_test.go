@@ -2,6 +2,7 @@ package truthbeam
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/config/confighttp"
@@ -61,6 +62,185 @@ func TestConfigValidate(t *testing.T) {
 			expectError: true,
 			errorMsg:    "must be specified",
 		},
+		{
+			name: "endpoint without scheme should fail",
+			config: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "localhost:8081",
+				},
+			},
+			expectError: true,
+			errorMsg:    "must use http or https",
+		},
+		{
+			name: "endpoint with unsupported scheme should fail",
+			config: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "grpc://localhost:8081",
+				},
+			},
+			expectError: true,
+			errorMsg:    "must use http or https",
+		},
+		{
+			name: "endpoint without host should fail",
+			config: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "http:///v1",
+				},
+			},
+			expectError: true,
+			errorMsg:    "has no host",
+		},
+		{
+			name: "unparsable endpoint should fail",
+			config: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "http://local host:8081",
+				},
+			},
+			expectError: true,
+			errorMsg:    "invalid endpoint",
+		},
+		{
+			name: "valid failover endpoints should pass",
+			config: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "http://localhost:8081",
+				},
+				FailoverEndpoints: []string{"http://compass-b:8081", "https://compass-c.example.com"},
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid failover endpoint should fail",
+			config: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "http://localhost:8081",
+				},
+				FailoverEndpoints: []string{"http://compass-b:8081", "compass-c:8081"},
+			},
+			expectError: true,
+			errorMsg:    "invalid failover endpoint 1",
+		},
+		{
+			name: "negative enrichment timeout should fail",
+			config: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "http://localhost:8081",
+				},
+				EnrichmentTimeout: -time.Second,
+			},
+			expectError: true,
+			errorMsg:    "enrichment timeout must not be negative",
+		},
+		{
+			name: "negative stats interval should fail",
+			config: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "http://localhost:8081",
+				},
+				StatsInterval: -time.Minute,
+			},
+			expectError: true,
+			errorMsg:    "stats interval must not be negative",
+		},
+		{
+			name: "negative prefetch rules refresh interval should fail",
+			config: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "http://localhost:8081",
+				},
+				PrefetchRules: PrefetchRulesConfig{Enabled: true, RefreshInterval: -time.Minute},
+			},
+			expectError: true,
+			errorMsg:    "refresh interval must not be negative",
+		},
+		{
+			name: "negative circuit breaker threshold should fail",
+			config: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "http://localhost:8081",
+				},
+				CircuitBreaker: CircuitBreakerConfig{FailureThreshold: -1},
+			},
+			expectError: true,
+			errorMsg:    "failure threshold must not be negative",
+		},
+		{
+			name: "negative max concurrent requests should fail",
+			config: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "http://localhost:8081",
+				},
+				MaxConcurrentRequests: -1,
+			},
+			expectError: true,
+			errorMsg:    "max concurrent requests must not be negative",
+		},
+		{
+			name: "circuit breaker without cooldown should fail",
+			config: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "http://localhost:8081",
+				},
+				CircuitBreaker: CircuitBreakerConfig{FailureThreshold: 5},
+			},
+			expectError: true,
+			errorMsg:    "cooldown must be positive",
+		},
+		{
+			name: "circuit breaker with cooldown should pass",
+			config: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "http://localhost:8081",
+				},
+				CircuitBreaker: CircuitBreakerConfig{FailureThreshold: 5, Cooldown: 30 * time.Second},
+			},
+			expectError: false,
+		},
+		{
+			name: "json body compliance format should pass",
+			config: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "http://localhost:8081",
+				},
+				ComplianceFormat: "json_body",
+			},
+			expectError: false,
+		},
+		{
+			name: "unknown compliance format should fail",
+			config: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "http://localhost:8081",
+				},
+				ComplianceFormat: "xml",
+			},
+			expectError: true,
+			errorMsg:    "unknown compliance format",
+		},
+		{
+			name: "status vocabulary for known statuses should pass",
+			config: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "http://localhost:8081",
+				},
+				StatusVocabulary: map[string]string{"Compliant": "PASS", "Non-Compliant": "FAIL"},
+			},
+			expectError: false,
+		},
+		{
+			name: "status vocabulary for unknown status should fail",
+			config: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "http://localhost:8081",
+				},
+				StatusVocabulary: map[string]string{"Passed": "PASS"},
+			},
+			expectError: true,
+			errorMsg:    "unknown compliance status",
+		},
 	}
 
 	for _, tt := range tests {
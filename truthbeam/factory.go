@@ -20,7 +20,8 @@ func NewFactory() processor.Factory {
 	return processor.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		processor.WithLogs(createLogsProcessor, metadata.LogsStability))
+		processor.WithLogs(createLogsProcessor, metadata.LogsStability),
+		processor.WithTraces(createTracesProcessor, metadata.TracesStability))
 }
 
 func createDefaultConfig() component.Config {
@@ -33,7 +34,9 @@ func createDefaultConfig() component.Config {
 	clientConfig.WriteBufferSize = 512 * 1024
 
 	return &Config{
-		ClientConfig: clientConfig,
+		ClientConfig:          clientConfig,
+		EnrichmentTimeout:     5 * time.Second,
+		MaxConcurrentRequests: 16,
 	}
 }
 
@@ -55,5 +58,28 @@ func createLogsProcessor(
 		beamProcessor.processLogs,
 		processorhelper.WithCapabilities(processorCapabilities),
 		processorhelper.WithStart(beamProcessor.start),
+		processorhelper.WithShutdown(beamProcessor.shutdown),
+	)
+}
+
+func createTracesProcessor(
+	ctx context.Context,
+	set processor.Settings,
+	cfg component.Config,
+	next consumer.Traces,
+) (processor.Traces, error) {
+	beamProcessor, err := newTruthBeamProcessor(cfg, set)
+	if err != nil {
+		return nil, err
+	}
+	return processorhelper.NewTraces(
+		ctx,
+		set,
+		cfg,
+		next,
+		beamProcessor.processTraces,
+		processorhelper.WithCapabilities(processorCapabilities),
+		processorhelper.WithStart(beamProcessor.start),
+		processorhelper.WithShutdown(beamProcessor.shutdown),
 	)
 }
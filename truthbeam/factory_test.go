@@ -1,12 +1,17 @@
 package truthbeam
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/processor/processortest"
+
+	"github.com/complytime/complybeacon/truthbeam/internal/metadata"
 )
 
 // The factory tests validate processor factory lifecycle including creation,
@@ -27,6 +32,8 @@ func TestCreateDefaultConfig(t *testing.T) {
 	assert.Equal(t, 30*time.Second, cfg.ClientConfig.Timeout, "Expected timeout 30s")
 	assert.Empty(t, cfg.ClientConfig.Compression, "Expected compression to be disabled by default for small payloads")
 	assert.Equal(t, 512*1024, cfg.ClientConfig.WriteBufferSize, "Expected write buffer size 512KB")
+	assert.Equal(t, 5*time.Second, cfg.EnrichmentTimeout, "Expected per-call enrichment timeout 5s")
+	assert.Equal(t, 16, cfg.MaxConcurrentRequests, "Expected at most 16 concurrent enrichment requests")
 }
 
 func TestCreateLogsProcessor(t *testing.T) {
@@ -45,6 +52,20 @@ func TestCreateLogsProcessor(t *testing.T) {
 	assert.Contains(t, err.Error(), "endpoint must be specified")
 }
 
+func TestCreateTracesProcessor(t *testing.T) {
+	factory := NewFactory()
+	cfg, ok := factory.CreateDefaultConfig().(*Config)
+	require.True(t, ok)
+	cfg.ClientConfig.Endpoint = "http://localhost:8081"
+
+	assert.Equal(t, metadata.TracesStability, factory.TracesStability())
+
+	traces, err := factory.CreateTraces(context.Background(), processortest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	require.NotNil(t, traces)
+	assert.True(t, traces.Capabilities().MutatesData)
+}
+
 func TestConfigValidation(t *testing.T) {
 	validConfig := getValidConfig()
 	err := validConfig.Validate()
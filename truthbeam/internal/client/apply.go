@@ -1,21 +1,264 @@
 package client
 
 import (
-	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"maps"
 	"net/http"
+	"slices"
 	"strings"
+	"time"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// EnrichmentClient enriches log records and spans with compliance data from compass.
+type EnrichmentClient interface {
+	ApplyAttributes(ctx context.Context, resource pcommon.Resource, logRecord plog.LogRecord) error
+	ApplySpanAttributes(ctx context.Context, resource pcommon.Resource, span ptrace.Span) error
+	ApplyUnmappedAttributes(logRecord plog.LogRecord, mapperID string) error
+	ApplyUnmappedSpanAttributes(span ptrace.Span, mapperID string) error
+}
+
+var _ EnrichmentClient = (*Client)(nil)
+
+// ApplyAttributes enriches attributes in the log record using this client.
+func (c *Client) ApplyAttributes(ctx context.Context, resource pcommon.Resource, logRecord plog.LogRecord) error {
+	return ApplyAttributes(ctx, c, resource, logRecord)
+}
+
+// ApplySpanAttributes enriches attributes in the span using this client.
+func (c *Client) ApplySpanAttributes(ctx context.Context, resource pcommon.Resource, span ptrace.Span) error {
+	return NewApplier(c).ApplySpanAttributes(ctx, resource, span)
+}
+
+// ApplyUnmappedAttributes marks the log record unmapped using this client.
+func (c *Client) ApplyUnmappedAttributes(logRecord plog.LogRecord, mapperID string) error {
+	return NewApplier(c).ApplyUnmappedAttributes(logRecord, mapperID)
+}
+
+// ApplyUnmappedSpanAttributes marks the span unmapped using this client.
+func (c *Client) ApplyUnmappedSpanAttributes(span ptrace.Span, mapperID string) error {
+	return NewApplier(c).ApplyUnmappedSpanAttributes(span, mapperID)
+}
+
+var _ EnrichmentClient = (*Applier)(nil)
+
+// Applier enriches log records through a compass client and writes the
+// compliance attributes under configurable keys.
+type Applier struct {
+	client   *Client
+	keys     KeyMapping
+	statuses StatusVocabulary
+	format   Format
+}
+
+// Format selects how an Applier writes the compliance data it receives.
+type Format string
+
+const (
+	// FormatAttributes writes each compliance field as its own attribute.
+	FormatAttributes Format = "attributes"
+	// FormatJSONAttribute writes the enrichment response as a JSON object to
+	// the single compliance attribute.
+	FormatJSONAttribute Format = "json_attribute"
+	// FormatJSONBody nests the log record body in a map under "body" and
+	// writes the enrichment response as a JSON object next to it under
+	// "compliance", so the original evidence is kept. Spans have no body, so
+	// they get the JSON attribute instead.
+	FormatJSONBody Format = "json_body"
+)
+
+const (
+	// complianceJSONKey is the attribute, and the body map key in
+	// FormatJSONBody, that holds the enrichment response in the JSON formats.
+	complianceJSONKey = "compliance"
+	// originalBodyKey is the body map key that holds the original log record
+	// body in FormatJSONBody.
+	originalBodyKey = "body"
+)
+
+// KeyMapping renames the compliance attribute keys written by an Applier.
+// The zero value keeps the attribute keys from attributes.go unchanged.
+type KeyMapping struct {
+	// Prefix is prepended to every compliance attribute key.
+	Prefix string
+	// Overrides rename individual compliance attribute keys. They are keyed
+	// by the default attribute key and take precedence over the prefix.
+	Overrides map[string]string
+}
+
+// Key returns the emitted attribute key for the default key.
+func (m KeyMapping) Key(defaultKey string) string {
+	if override, ok := m.Overrides[defaultKey]; ok {
+		return override
+	}
+	return m.Prefix + defaultKey
+}
+
+// StatusVocabulary renames the compliance status values written by an
+// Applier, e.g. "PASS" for Compliant. Statuses without an entry are written
+// as compass reports them.
+type StatusVocabulary map[ComplianceStatus]string
+
+// Format returns the emitted value for status.
+func (v StatusVocabulary) Format(status ComplianceStatus) string {
+	if value, ok := v[status]; ok {
+		return value
+	}
+	return string(status)
+}
+
+// ApplierOption configures an Applier.
+type ApplierOption func(*Applier)
+
+// WithKeyPrefix prepends prefix to every emitted compliance attribute key.
+func WithKeyPrefix(prefix string) ApplierOption {
+	return func(a *Applier) {
+		a.keys.Prefix = prefix
+	}
+}
+
+// WithKeyOverrides renames individual compliance attribute keys. Overrides
+// are keyed by the default attribute key and take precedence over the prefix.
+func WithKeyOverrides(overrides map[string]string) ApplierOption {
+	return func(a *Applier) {
+		if a.keys.Overrides == nil {
+			a.keys.Overrides = make(map[string]string, len(overrides))
+		}
+		for key, override := range overrides {
+			a.keys.Overrides[key] = override
+		}
+	}
+}
+
+// WithKeyMapping renames the compliance attribute keys as described by
+// mapping, replacing any prefix or overrides set before it.
+func WithKeyMapping(mapping KeyMapping) ApplierOption {
+	return func(a *Applier) {
+		a.keys = KeyMapping{Prefix: mapping.Prefix}
+		WithKeyOverrides(mapping.Overrides)(a)
+	}
+}
+
+// WithStatusVocabulary writes compliance statuses using vocabulary instead of
+// the compass status names.
+func WithStatusVocabulary(vocabulary StatusVocabulary) ApplierOption {
+	return func(a *Applier) {
+		a.statuses = make(StatusVocabulary, len(vocabulary))
+		for status, value := range vocabulary {
+			a.statuses[status] = value
+		}
+	}
+}
+
+// WithFormat selects how compliance data is written. The enrichment status,
+// lag, and mapper ID are always written as attributes, so enriched records
+// look the same in every format.
+func WithFormat(format Format) ApplierOption {
+	return func(a *Applier) {
+		a.format = format
+	}
+}
+
+// NewApplier returns an Applier that enriches log records using client.
+// Without options, the attribute keys from attributes.go and the compass
+// status names are used unchanged.
+func NewApplier(client *Client, opts ...ApplierOption) *Applier {
+	a := &Applier{client: client, format: FormatAttributes}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// Client returns the compass client the Applier sends requests through.
+func (a *Applier) Client() *Client {
+	return a.client
+}
+
+// key returns the emitted attribute key for the default key.
+func (a *Applier) key(defaultKey string) string {
+	return a.keys.Key(defaultKey)
+}
+
+// ApplyAttributes enriches attributes in the log record with compliance impact data
+// using the default attribute keys.
+func ApplyAttributes(ctx context.Context, client *Client, resource pcommon.Resource, logRecord plog.LogRecord) error {
+	return NewApplier(client).ApplyAttributes(ctx, resource, logRecord)
+}
+
 // ApplyAttributes enriches attributes in the log record with compliance impact data.
-func ApplyAttributes(ctx context.Context, client *Client, serverURL string, _ pcommon.Resource, logRecord plog.LogRecord) error {
-	attrs := logRecord.Attributes()
+// Requests are sent to the client's configured server using its HTTP client, so
+// timeouts, compression, and TLS settings apply.
+func (a *Applier) ApplyAttributes(ctx context.Context, _ pcommon.Resource, logRecord plog.LogRecord) error {
+	ctx = traceContext(ctx, logRecord.TraceID(), logRecord.SpanID(), logRecord.Flags().IsSampled())
+	body := logRecord.Body()
+	return a.applyAttributes(ctx, logRecord.Attributes(), &body, logRecord.Timestamp())
+}
+
+// ApplySpanAttributes enriches attributes in a span that records a policy
+// evaluation, such as one emitted by a policy engine per rule. The span's end
+// time is used as the evidence time, falling back to its start time.
+func (a *Applier) ApplySpanAttributes(ctx context.Context, _ pcommon.Resource, span ptrace.Span) error {
+	ctx = traceContext(ctx, span.TraceID(), span.SpanID(), span.Flags()&uint32(trace.FlagsSampled) != 0)
+	return a.applyAttributes(ctx, span.Attributes(), nil, spanTimestamp(span))
+}
+
+// ApplyUnmappedAttributes writes the attributes of an unmapped compass result
+// to the log record without calling compass, for evidence known ahead of time
+// to be unmapped. mapperID is the mapper compass reports for such evidence;
+// it is not written when empty.
+func (a *Applier) ApplyUnmappedAttributes(logRecord plog.LogRecord, mapperID string) error {
+	body := logRecord.Body()
+	return a.putCompliance(logRecord.Attributes(), &body, logRecord.Timestamp(), unmappedResponse(mapperID))
+}
+
+// ApplyUnmappedSpanAttributes writes the attributes of an unmapped compass
+// result to the span without calling compass, like ApplyUnmappedAttributes.
+func (a *Applier) ApplyUnmappedSpanAttributes(span ptrace.Span, mapperID string) error {
+	return a.putCompliance(span.Attributes(), nil, spanTimestamp(span), unmappedResponse(mapperID))
+}
+
+// spanTimestamp returns the evidence time of a span: its end time, falling
+// back to its start time.
+func spanTimestamp(span ptrace.Span) pcommon.Timestamp {
+	if timestamp := span.EndTimestamp(); timestamp != 0 {
+		return timestamp
+	}
+	return span.StartTimestamp()
+}
 
+// unmappedResponse returns the enrichment response compass sends for evidence
+// no mapper maps.
+func unmappedResponse(mapperID string) EnrichmentResponse {
+	response := EnrichmentResponse{
+		Compliance: Compliance{
+			Status:           ComplianceStatusUnknown,
+			EnrichmentStatus: ComplianceEnrichmentStatusUnmapped,
+			Frameworks: ComplianceFrameworks{
+				Frameworks:   []string{},
+				Requirements: []string{},
+			},
+		},
+	}
+	if mapperID != "" {
+		response.MapperId = &mapperID
+	}
+	return response
+}
+
+// applyAttributes enriches attrs with compliance impact data for the evidence
+// they describe, observed at timestamp. body is the log record body, or nil
+// for spans.
+func (a *Applier) applyAttributes(ctx context.Context, attrs pcommon.Map, body *pcommon.Value, timestamp pcommon.Timestamp) error {
 	// Retrieve lookup attributes
 	var missingAttrs []string
 
@@ -35,38 +278,87 @@ func ApplyAttributes(ctx context.Context, client *Client, serverURL string, _ pc
 	}
 
 	if len(missingAttrs) > 0 {
-		attrs.PutStr(COMPLIANCE_ENRICHMENT_STATUS, string(ComplianceEnrichmentStatusSkipped))
+		attrs.PutStr(a.key(COMPLIANCE_ENRICHMENT_STATUS), string(ComplianceEnrichmentStatusSkipped))
 		return fmt.Errorf("missing required attributes: %s", strings.Join(missingAttrs, ", "))
 	}
 
 	enrichReq := EnrichmentRequest{
 		Evidence: Evidence{
-			Timestamp:              logRecord.Timestamp().AsTime(),
+			Timestamp:              timestamp.AsTime(),
 			PolicyEngineName:       policySourceVal.Str(),
 			PolicyRuleId:           policyRuleIDVal.Str(),
 			PolicyEvaluationStatus: EvidencePolicyEvaluationStatus(policyEvalStatusVal.Str()),
 		},
 	}
+	if policyRuleNameVal, ok := attrs.Get(POLICY_RULE_NAME); ok && policyRuleNameVal.Str() != "" {
+		policyRuleName := policyRuleNameVal.Str()
+		enrichReq.Evidence.PolicyRuleName = &policyRuleName
+	}
+	enrichReq.Evidence.ChecksPassed = checkCount(attrs, POLICY_EVALUATION_CHECKS_PASSED)
+	enrichReq.Evidence.ChecksFailed = checkCount(attrs, POLICY_EVALUATION_CHECKS_FAILED)
 
-	enrichRes, err := callEnrichAPI(ctx, client, serverURL, enrichReq)
+	enrichRes, err := callEnrichAPI(ctx, a.client, enrichReq)
 	if err != nil {
 		return err
 	}
+	return a.putCompliance(attrs, body, timestamp, *enrichRes)
+}
 
+// putCompliance writes the enrichment response for evidence observed at
+// timestamp to attrs, or to body for the JSON body format.
+func (a *Applier) putCompliance(attrs pcommon.Map, body *pcommon.Value, timestamp pcommon.Timestamp, enrichRes EnrichmentResponse) error {
 	// Add enrichment status
-	attrs.PutStr(COMPLIANCE_ENRICHMENT_STATUS, string(enrichRes.Compliance.EnrichmentStatus))
+	attrs.PutStr(a.key(COMPLIANCE_ENRICHMENT_STATUS), string(enrichRes.Compliance.EnrichmentStatus))
+
+	// Record how far enrichment trails the evidence so pipeline backlog is visible.
+	if timestamp != 0 {
+		attrs.PutInt(a.key(COMPLIANCE_ENRICHMENT_LAG_MS), time.Since(timestamp.AsTime()).Milliseconds())
+	}
+
+	// Record the mapper plugin so a fallback to the basic mapper is visible.
+	if enrichRes.MapperId != nil && *enrichRes.MapperId != "" {
+		attrs.PutStr(a.key(COMPLIANCE_MAPPER_ID), *enrichRes.MapperId)
+	}
+
+	if a.format == FormatJSONAttribute || a.format == FormatJSONBody {
+		return a.putJSON(attrs, body, enrichRes)
+	}
 
 	// Only add compliance attributes if enrichment was successful
 	if enrichRes.Compliance.EnrichmentStatus == ComplianceEnrichmentStatusSuccess {
-		attrs.PutStr(COMPLIANCE_STATUS, string(enrichRes.Compliance.Status))
-		attrs.PutStr(COMPLIANCE_CONTROL_ID, enrichRes.Compliance.Control.Id)
-		attrs.PutStr(COMPLIANCE_CONTROL_CATALOG_ID, enrichRes.Compliance.Control.CatalogId)
-		attrs.PutStr(COMPLIANCE_CONTROL_CATEGORY, enrichRes.Compliance.Control.Category)
-		requirements := attrs.PutEmptySlice(COMPLIANCE_REQUIREMENTS)
-		standards := attrs.PutEmptySlice(COMPLIANCE_FRAMEWORKS)
+		attrs.PutStr(a.key(COMPLIANCE_STATUS), a.statuses.Format(enrichRes.Compliance.Status))
+		attrs.PutStr(a.key(COMPLIANCE_CONTROL_ID), enrichRes.Compliance.Control.Id)
+		attrs.PutStr(a.key(COMPLIANCE_CONTROL_CATALOG_ID), enrichRes.Compliance.Control.CatalogId)
+		if enrichRes.Compliance.Control.CatalogVersion != nil {
+			attrs.PutStr(a.key(COMPLIANCE_CONTROL_CATALOG_VERSION), *enrichRes.Compliance.Control.CatalogVersion)
+		}
+		attrs.PutStr(a.key(COMPLIANCE_CONTROL_CATEGORY), enrichRes.Compliance.Control.Category)
+		if parameters := enrichRes.Compliance.Control.Parameters; parameters != nil {
+			for _, id := range slices.Sorted(maps.Keys(*parameters)) {
+				attrs.PutStr(a.key(COMPLIANCE_CONTROL_PARAMETERS)+"."+id, (*parameters)[id])
+			}
+		}
+		requirements := attrs.PutEmptySlice(a.key(COMPLIANCE_REQUIREMENTS))
+		standards := attrs.PutEmptySlice(a.key(COMPLIANCE_FRAMEWORKS))
 
 		if enrichRes.Compliance.Control.RemediationDescription != nil {
-			attrs.PutStr(COMPLIANCE_REMEDIATION_DESCRIPTION, *enrichRes.Compliance.Control.RemediationDescription)
+			attrs.PutStr(a.key(COMPLIANCE_REMEDIATION_DESCRIPTION), *enrichRes.Compliance.Control.RemediationDescription)
+		}
+		if enrichRes.Compliance.Control.AssessmentProcedure != nil {
+			attrs.PutStr(a.key(COMPLIANCE_CONTROL_ASSESSMENT_PROCEDURE), *enrichRes.Compliance.Control.AssessmentProcedure)
+		}
+
+		// The action the source actually took wins over the recommended one.
+		// Sources report it under the default key.
+		if enrichRes.Compliance.RemediationAction != nil {
+			sourceAction, ok := attrs.Get(COMPLIANCE_REMEDIATION_ACTION)
+			if !ok || sourceAction.Str() == string(ComplianceRemediationActionUnknown) {
+				attrs.PutStr(a.key(COMPLIANCE_REMEDIATION_ACTION), string(*enrichRes.Compliance.RemediationAction))
+			}
+		}
+
+		if risk := enrichRes.Compliance.Risk; risk != nil && risk.Score != nil {
+			attrs.PutDouble(a.key(COMPLIANCE_RISK_SCORE), *risk.Score)
 		}
 
 		for _, req := range enrichRes.Compliance.Frameworks.Requirements {
@@ -82,32 +374,57 @@ func ApplyAttributes(ctx context.Context, client *Client, serverURL string, _ pc
 	return nil
 }
 
-// callEnrichAPI is a helper function to perform the actual HTTP request.
-func callEnrichAPI(ctx context.Context, client *Client, serverURL string, req EnrichmentRequest) (*EnrichmentResponse, error) {
-	body, err := json.Marshal(req)
+// putJSON writes the enrichment response as JSON next to the original body
+// when the format and record allow it, and to the compliance attribute
+// otherwise.
+func (a *Applier) putJSON(attrs pcommon.Map, body *pcommon.Value, enrichRes EnrichmentResponse) error {
+	enrichRes.Compliance.Status = ComplianceStatus(a.statuses.Format(enrichRes.Compliance.Status))
+	data, err := json.Marshal(enrichRes)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("failed to encode compliance data: %w", err)
 	}
-
-	// Create the HTTP request
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", serverURL+"/v1/enrich", bytes.NewReader(body))
-	if err != nil {
-		return nil, err
+	if a.format == FormatJSONBody && body != nil {
+		original := pcommon.NewValueEmpty()
+		body.CopyTo(original)
+		nested := body.SetEmptyMap()
+		original.CopyTo(nested.PutEmpty(originalBodyKey))
+		nested.PutStr(complianceJSONKey, string(data))
+		return nil
 	}
+	attrs.PutStr(a.key(complianceJSONKey), string(data))
+	return nil
+}
 
-	httpReq.Header.Set("Content-Type", "application/json")
+// checkCount returns the per-check count stored under key, or nil when the
+// record does not carry a valid count.
+func checkCount(attrs pcommon.Map, key string) *int {
+	val, ok := attrs.Get(key)
+	if !ok || val.Type() != pcommon.ValueTypeInt || val.Int() < 0 {
+		return nil
+	}
+	count := int(val.Int())
+	return &count
+}
 
+// callEnrichAPI is a helper function to perform the actual HTTP request.
+func callEnrichAPI(ctx context.Context, client *Client, req EnrichmentRequest) (*EnrichmentResponse, error) {
 	// Perform the request
-	resp, err := client.Client.Do(httpReq)
+	resp, err := client.PostV1Enrich(ctx, req, acceptGzip, injectTraceContext)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
+	respBody, err := responseBody(resp)
+	if err != nil {
+		return nil, err
+	}
+	defer respBody.Close()
+
 	// Handle non-200 status codes
 	if resp.StatusCode != http.StatusOK {
 		var errRes Error
-		err := json.NewDecoder(resp.Body).Decode(&errRes)
+		err := json.NewDecoder(respBody).Decode(&errRes)
 		if err != nil {
 			return nil, err
 		}
@@ -116,9 +433,57 @@ func callEnrichAPI(ctx context.Context, client *Client, serverURL string, req En
 
 	// Decode the successful response
 	var enrichRes EnrichmentResponse
-	if err := json.NewDecoder(resp.Body).Decode(&enrichRes); err != nil {
+	if err := json.NewDecoder(respBody).Decode(&enrichRes); err != nil {
 		return nil, err
 	}
 
 	return &enrichRes, nil
 }
+
+// acceptGzip requests gzip encoded responses. Setting Accept-Encoding explicitly
+// disables the transport's transparent decompression, so gzip responses are
+// decoded in responseBody.
+func acceptGzip(_ context.Context, req *http.Request) error {
+	req.Header.Set("Accept-Encoding", "gzip")
+	return nil
+}
+
+// traceContext returns ctx carrying the span context of the record being
+// enriched, if it has one, so the call to compass is linked to the trace that
+// produced the record.
+func traceContext(ctx context.Context, traceID pcommon.TraceID, spanID pcommon.SpanID, sampled bool) context.Context {
+	if traceID.IsEmpty() || spanID.IsEmpty() {
+		return ctx
+	}
+
+	var flags trace.TraceFlags
+	if sampled {
+		flags = trace.FlagsSampled
+	}
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID(traceID),
+		SpanID:     trace.SpanID(spanID),
+		TraceFlags: flags,
+		Remote:     true,
+	})
+	return trace.ContextWithRemoteSpanContext(ctx, spanContext)
+}
+
+// injectTraceContext sets the W3C traceparent header from the span context in ctx.
+func injectTraceContext(ctx context.Context, req *http.Request) error {
+	propagation.TraceContext{}.Inject(ctx, propagation.HeaderCarrier(req.Header))
+	return nil
+}
+
+// responseBody returns a reader over the decoded response body based on
+// its Content-Encoding.
+func responseBody(resp *http.Response) (io.ReadCloser, error) {
+	if !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return io.NopCloser(resp.Body), nil
+	}
+	gz, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode gzip response: %w", err)
+	}
+	return gz, nil
+}
@@ -1,10 +1,15 @@
 package client
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,6 +17,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // The apply tests validate attribute application logic for enrichment of log records
@@ -41,6 +48,7 @@ func TestApplyAttributes(t *testing.T) {
 			Compliance: Compliance{
 				Control: ComplianceControl{
 					CatalogId:              "NIST-800-53",
+					CatalogVersion:         stringPtr("rev5"),
 					Category:               "Access Control",
 					Id:                     "AC-1",
 					RemediationDescription: stringPtr("Implement proper access controls"),
@@ -70,7 +78,7 @@ func TestApplyAttributes(t *testing.T) {
 
 	// Apply attributes for log enrichment
 	ctx := context.Background()
-	err = ApplyAttributes(ctx, client, mockServer.URL, resource, logRecord)
+	err = ApplyAttributes(ctx, client, resource, logRecord)
 	require.NoError(t, err)
 
 	// Verify that compliance attributes were added
@@ -78,6 +86,7 @@ func TestApplyAttributes(t *testing.T) {
 		COMPLIANCE_STATUS:                  "Pass",
 		COMPLIANCE_CONTROL_ID:              "AC-1",
 		COMPLIANCE_CONTROL_CATALOG_ID:      "NIST-800-53",
+		COMPLIANCE_CONTROL_CATALOG_VERSION: "rev5",
 		COMPLIANCE_CONTROL_CATEGORY:        "Access Control",
 		COMPLIANCE_REMEDIATION_DESCRIPTION: "Implement proper access controls",
 	})
@@ -143,7 +152,7 @@ func TestApplyAttributesMissingRequiredAttributes(t *testing.T) {
 			tt.configRecord(logRecord)
 
 			ctx := context.Background()
-			err := ApplyAttributes(ctx, client, "http://localhost:8081", resource, logRecord)
+			err := ApplyAttributes(ctx, client, resource, logRecord)
 			assert.Error(t, err)
 			assert.Contains(t, err.Error(), "missing required attribute")
 			assert.Contains(t, err.Error(), tt.expectedAttribute)
@@ -260,14 +269,948 @@ func TestApplyAttributes_ServerResponses(t *testing.T) {
 
 			logRecord, resource := createTestLogRecord()
 			ctx := context.Background()
-			err = ApplyAttributes(ctx, client, endpoint, resource, logRecord)
+			err = ApplyAttributes(ctx, client, resource, logRecord)
 
 			tt.assertFunc(t, logRecord.Attributes().AsRaw(), err)
 		})
 	}
 }
 
+// TestApplyAttributes_GzipResponses verifies gzip encoded responses from compass are decoded.
+func TestApplyAttributes_GzipResponses(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       interface{}
+		assertFunc func(t *testing.T, attrs map[string]interface{}, err error)
+	}{
+		{
+			name:       "gzip success response",
+			statusCode: http.StatusOK,
+			body: EnrichmentResponse{
+				Compliance: Compliance{
+					Control: ComplianceControl{
+						CatalogId: "NIST-800-53",
+						Category:  "Access Control",
+						Id:        "AC-1",
+					},
+					Frameworks: ComplianceFrameworks{
+						Requirements: []string{"req-1"},
+						Frameworks:   []string{"NIST-800-53"},
+					},
+					Status:           ComplianceStatusCompliant,
+					EnrichmentStatus: ComplianceEnrichmentStatusSuccess,
+				},
+			},
+			assertFunc: func(t *testing.T, attrs map[string]interface{}, err error) {
+				require.NoError(t, err)
+				assertAttributesEqual(t, attrs, map[string]interface{}{
+					COMPLIANCE_STATUS:     string(ComplianceStatusCompliant),
+					COMPLIANCE_CONTROL_ID: "AC-1",
+				})
+			},
+		},
+		{
+			name:       "gzip error response",
+			statusCode: http.StatusBadRequest,
+			body:       Error{Code: 400, Message: "Invalid format for enrichment"},
+			assertFunc: func(t *testing.T, _ map[string]interface{}, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "API call failed with status 400: Invalid format for enrichment")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "gzip", r.Header.Get("Accept-Encoding"))
+
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("Content-Encoding", "gzip")
+				w.WriteHeader(tt.statusCode)
+
+				gz := gzip.NewWriter(w)
+				defer gz.Close()
+				_ = json.NewEncoder(gz).Encode(tt.body)
+			}))
+			defer mockServer.Close()
+
+			client, err := NewClient(mockServer.URL)
+			require.NoError(t, err)
+
+			logRecord, resource := createTestLogRecord()
+			err = ApplyAttributes(context.Background(), client, resource, logRecord)
+
+			tt.assertFunc(t, logRecord.Attributes().AsRaw(), err)
+		})
+	}
+}
+
+// TestApplyAttributes_TraceContextPropagation verifies the log record's trace context is sent to compass.
+func TestApplyAttributes_TraceContextPropagation(t *testing.T) {
+	traceID := pcommon.TraceID([16]byte{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36})
+	spanID := pcommon.SpanID([8]byte{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7})
+
+	tests := []struct {
+		name                string
+		withTrace           bool
+		sampled             bool
+		expectedTraceparent string
+	}{
+		{
+			name:                "sampled record",
+			withTrace:           true,
+			sampled:             true,
+			expectedTraceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		},
+		{
+			name:                "unsampled record",
+			withTrace:           true,
+			sampled:             false,
+			expectedTraceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00",
+		},
+		{
+			name:                "record without trace context",
+			withTrace:           false,
+			expectedTraceparent: "",
+		},
+	}
+
+	traceparentPattern := regexp.MustCompile(`^00-[0-9a-f]{32}-[0-9a-f]{16}-[0-9a-f]{2}$`)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var traceparent string
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				traceparent = r.Header.Get("traceparent")
+				_ = json.NewEncoder(w).Encode(EnrichmentResponse{
+					Compliance: Compliance{EnrichmentStatus: ComplianceEnrichmentStatusUnmapped},
+				})
+			}))
+			defer mockServer.Close()
+
+			client, err := NewClient(mockServer.URL)
+			require.NoError(t, err)
+
+			logRecord, resource := createTestLogRecord()
+			if tt.withTrace {
+				logRecord.SetTraceID(traceID)
+				logRecord.SetSpanID(spanID)
+				logRecord.SetFlags(plog.DefaultLogRecordFlags.WithIsSampled(tt.sampled))
+			}
+
+			err = ApplyAttributes(context.Background(), client, resource, logRecord)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedTraceparent, traceparent)
+			if tt.expectedTraceparent != "" {
+				assert.Regexp(t, traceparentPattern, traceparent)
+			}
+		})
+	}
+}
+
+// TestApplyAttributes_HonorsClientTimeout verifies requests go through the configured HTTP client.
+func TestApplyAttributes_HonorsClientTimeout(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(2 * time.Second):
+		}
+	}))
+	defer mockServer.Close()
+
+	client, err := NewClient(mockServer.URL, WithHTTPClient(&http.Client{Timeout: 50 * time.Millisecond}))
+	require.NoError(t, err)
+
+	logRecord, resource := createTestLogRecord()
+	start := time.Now()
+	err = ApplyAttributes(context.Background(), client, resource, logRecord)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Client.Timeout exceeded")
+	assert.Less(t, time.Since(start), time.Second)
+}
+
 // assertAttributesEqual compares expected key/value pairs against the attributes map.
+// TestApplyAttributes_RemediationAction verifies the recommended remediation action
+// never overrides an action already reported by the source.
+func TestApplyAttributes_RemediationAction(t *testing.T) {
+	notify := ComplianceRemediationActionNotify
+
+	tests := []struct {
+		name           string
+		sourceAction   string
+		recommended    *ComplianceRemediationAction
+		expectedAction string
+	}{
+		{
+			name:           "recommended action is applied",
+			recommended:    &notify,
+			expectedAction: "Notify",
+		},
+		{
+			name:           "source action is preferred",
+			sourceAction:   "Block",
+			recommended:    &notify,
+			expectedAction: "Block",
+		},
+		{
+			name:           "unknown source action is replaced",
+			sourceAction:   "Unknown",
+			recommended:    &notify,
+			expectedAction: "Notify",
+		},
+		{
+			name: "no recommendation leaves action unset",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(EnrichmentResponse{
+					Compliance: Compliance{
+						Control: ComplianceControl{
+							CatalogId: "NIST-800-53",
+							Category:  "Access Control",
+							Id:        "AC-1",
+						},
+						Frameworks: ComplianceFrameworks{
+							Requirements: []string{"req-1"},
+							Frameworks:   []string{"NIST-800-53"},
+						},
+						RemediationAction: tt.recommended,
+						Status:            ComplianceStatusNonCompliant,
+						EnrichmentStatus:  ComplianceEnrichmentStatusSuccess,
+					},
+				})
+			}))
+			defer mockServer.Close()
+
+			client, err := NewClient(mockServer.URL)
+			require.NoError(t, err)
+
+			logRecord, resource := createTestLogRecord()
+			if tt.sourceAction != "" {
+				logRecord.Attributes().PutStr(COMPLIANCE_REMEDIATION_ACTION, tt.sourceAction)
+			}
+
+			err = ApplyAttributes(context.Background(), client, resource, logRecord)
+			require.NoError(t, err)
+
+			action, ok := logRecord.Attributes().Get(COMPLIANCE_REMEDIATION_ACTION)
+			if tt.expectedAction == "" {
+				assert.False(t, ok, "remediation action should not be set")
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, tt.expectedAction, action.Str())
+		})
+	}
+}
+
+// TestApplyAttributes_RemediationActionWithPrefix verifies the source action
+// is read from the default key when the emitted keys are prefixed.
+func TestApplyAttributes_RemediationActionWithPrefix(t *testing.T) {
+	notify := ComplianceRemediationActionNotify
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(EnrichmentResponse{
+			Compliance: Compliance{
+				Control:           ComplianceControl{CatalogId: "NIST-800-53", Id: "AC-1"},
+				RemediationAction: &notify,
+				Status:            ComplianceStatusNonCompliant,
+				EnrichmentStatus:  ComplianceEnrichmentStatusSuccess,
+			},
+		})
+	}))
+	defer mockServer.Close()
+
+	client, err := NewClient(mockServer.URL)
+	require.NoError(t, err)
+
+	logRecord, resource := createTestLogRecord()
+	logRecord.Attributes().PutStr(COMPLIANCE_REMEDIATION_ACTION, "Block")
+
+	err = NewApplier(client, WithKeyPrefix("acme.")).ApplyAttributes(context.Background(), resource, logRecord)
+	require.NoError(t, err)
+
+	raw := logRecord.Attributes().AsRaw()
+	assert.Equal(t, "Block", raw[COMPLIANCE_REMEDIATION_ACTION])
+	assert.NotContains(t, raw, "acme."+COMPLIANCE_REMEDIATION_ACTION, "the source action should win")
+}
+
+// TestApplyAttributes_EnrichmentLag verifies the lag between the evidence
+// timestamp and enrichment is recorded.
+func TestApplyAttributes_EnrichmentLag(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(EnrichmentResponse{
+			Compliance: Compliance{
+				EnrichmentStatus: ComplianceEnrichmentStatusUnmapped,
+			},
+		})
+	}))
+	defer mockServer.Close()
+
+	client, err := NewClient(mockServer.URL)
+	require.NoError(t, err)
+
+	t.Run("lag from evidence timestamp", func(t *testing.T) {
+		logRecord, resource := createTestLogRecord()
+		logRecord.SetTimestamp(pcommon.NewTimestampFromTime(time.Now().Add(-2 * time.Second)))
+
+		err := ApplyAttributes(context.Background(), client, resource, logRecord)
+		require.NoError(t, err)
+
+		lag, ok := logRecord.Attributes().Get(COMPLIANCE_ENRICHMENT_LAG_MS)
+		require.True(t, ok)
+		assert.GreaterOrEqual(t, lag.Int(), int64(2000))
+		assert.Less(t, lag.Int(), int64(time.Minute/time.Millisecond))
+	})
+
+	t.Run("no lag without timestamp", func(t *testing.T) {
+		logRecord, resource := createTestLogRecord()
+
+		err := ApplyAttributes(context.Background(), client, resource, logRecord)
+		require.NoError(t, err)
+
+		_, ok := logRecord.Attributes().Get(COMPLIANCE_ENRICHMENT_LAG_MS)
+		assert.False(t, ok, "lag should not be set without an evidence timestamp")
+	})
+}
+
+func TestApplier_StatusVocabulary(t *testing.T) {
+	vocabulary := StatusVocabulary{
+		ComplianceStatusCompliant:    "PASS",
+		ComplianceStatusNonCompliant: "FAIL",
+	}
+
+	tests := []struct {
+		name     string
+		opts     []ApplierOption
+		status   ComplianceStatus
+		expected string
+	}{
+		{
+			name:     "default vocabulary",
+			status:   ComplianceStatusCompliant,
+			expected: "Compliant",
+		},
+		{
+			name:     "custom compliant value",
+			opts:     []ApplierOption{WithStatusVocabulary(vocabulary)},
+			status:   ComplianceStatusCompliant,
+			expected: "PASS",
+		},
+		{
+			name:     "custom non-compliant value",
+			opts:     []ApplierOption{WithStatusVocabulary(vocabulary)},
+			status:   ComplianceStatusNonCompliant,
+			expected: "FAIL",
+		},
+		{
+			name:     "status without an entry is unchanged",
+			opts:     []ApplierOption{WithStatusVocabulary(vocabulary)},
+			status:   ComplianceStatusNeedsReview,
+			expected: "Needs Review",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(EnrichmentResponse{
+					Compliance: Compliance{
+						Control:          ComplianceControl{CatalogId: "NIST-800-53", Id: "AC-1"},
+						Status:           tt.status,
+						EnrichmentStatus: ComplianceEnrichmentStatusSuccess,
+					},
+				})
+			}))
+			defer mockServer.Close()
+
+			client, err := NewClient(mockServer.URL)
+			require.NoError(t, err)
+
+			logRecord, resource := createTestLogRecord()
+			err = NewApplier(client, tt.opts...).ApplyAttributes(context.Background(), resource, logRecord)
+			require.NoError(t, err)
+
+			status, ok := logRecord.Attributes().Get(COMPLIANCE_STATUS)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, status.Str())
+		})
+	}
+}
+
+func TestApplyAttributes_ControlParameters(t *testing.T) {
+	tests := []struct {
+		name       string
+		parameters *map[string]string
+		expected   map[string]any
+	}{
+		{
+			name:       "control with parameters",
+			parameters: &map[string]string{"ac-2_prm_1": "quarterly", "ac-2_prm_2": "service, shared"},
+			expected: map[string]any{
+				COMPLIANCE_CONTROL_PARAMETERS + ".ac-2_prm_1": "quarterly",
+				COMPLIANCE_CONTROL_PARAMETERS + ".ac-2_prm_2": "service, shared",
+			},
+		},
+		{
+			name:     "control without parameters",
+			expected: map[string]any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(EnrichmentResponse{
+					Compliance: Compliance{
+						Control: ComplianceControl{
+							CatalogId:  "EXAMPLE",
+							Id:         "AC-2",
+							Parameters: tt.parameters,
+						},
+						Status:           ComplianceStatusCompliant,
+						EnrichmentStatus: ComplianceEnrichmentStatusSuccess,
+					},
+				})
+			}))
+			defer mockServer.Close()
+
+			client, err := NewClient(mockServer.URL)
+			require.NoError(t, err)
+
+			logRecord, resource := createTestLogRecord()
+			err = ApplyAttributes(context.Background(), client, resource, logRecord)
+			require.NoError(t, err)
+
+			parameters := map[string]any{}
+			for key, value := range logRecord.Attributes().AsRaw() {
+				if strings.HasPrefix(key, COMPLIANCE_CONTROL_PARAMETERS+".") {
+					parameters[key] = value
+				}
+			}
+			assert.Equal(t, tt.expected, parameters)
+		})
+	}
+}
+
+func TestApplyAttributes_AssessmentProcedure(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(EnrichmentResponse{
+			Compliance: Compliance{
+				Control: ComplianceControl{
+					CatalogId:              "OSPS-B",
+					Id:                     "OSPS-QA-07.01",
+					RemediationDescription: stringPtr("Require at least one approval before merging to the main branch"),
+					AssessmentProcedure:    stringPtr("Check that branch protection requires at least one approval"),
+				},
+				Status:           ComplianceStatusNonCompliant,
+				EnrichmentStatus: ComplianceEnrichmentStatusSuccess,
+			},
+		})
+	}))
+	defer mockServer.Close()
+
+	client, err := NewClient(mockServer.URL)
+	require.NoError(t, err)
+
+	logRecord, resource := createTestLogRecord()
+	err = ApplyAttributes(context.Background(), client, resource, logRecord)
+	require.NoError(t, err)
+
+	attrs := logRecord.Attributes().AsRaw()
+	assert.Equal(t, "Require at least one approval before merging to the main branch", attrs[COMPLIANCE_REMEDIATION_DESCRIPTION])
+	assert.Equal(t, "Check that branch protection requires at least one approval", attrs[COMPLIANCE_CONTROL_ASSESSMENT_PROCEDURE])
+}
+
+func TestApplier_JSONFormat(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(EnrichmentResponse{
+			Compliance: Compliance{
+				Control:          ComplianceControl{CatalogId: "OSPS-B", Category: "Quality Assurance", Id: "OSPS-QA-07.01"},
+				Frameworks:       ComplianceFrameworks{Frameworks: []string{"NIST-800-53"}, Requirements: []string{"CM-3"}},
+				Status:           ComplianceStatusCompliant,
+				EnrichmentStatus: ComplianceEnrichmentStatusSuccess,
+			},
+		})
+	}))
+	defer mockServer.Close()
+
+	client, err := NewClient(mockServer.URL)
+	require.NoError(t, err)
+
+	expected := `{
+		"compliance": {
+			"control": {"catalogId": "OSPS-B", "category": "Quality Assurance", "id": "OSPS-QA-07.01"},
+			"frameworks": {"frameworks": ["NIST-800-53"], "requirements": ["CM-3"]},
+			"status": "PASS",
+			"enrichmentStatus": "Success"
+		}
+	}`
+	opts := []ApplierOption{
+		WithKeyPrefix("acme."),
+		WithStatusVocabulary(StatusVocabulary{ComplianceStatusCompliant: "PASS"}),
+	}
+
+	t.Run("json attribute", func(t *testing.T) {
+		logRecord, resource := createTestLogRecord()
+		logRecord.Body().SetStr("raw evidence")
+		applier := NewApplier(client, append(opts, WithFormat(FormatJSONAttribute))...)
+		require.NoError(t, applier.ApplyAttributes(context.Background(), resource, logRecord))
+
+		compliance, ok := logRecord.Attributes().Get("acme.compliance")
+		require.True(t, ok)
+		assert.JSONEq(t, expected, compliance.Str())
+		assert.Equal(t, "raw evidence", logRecord.Body().Str(), "body is left unchanged")
+		_, ok = logRecord.Attributes().Get("acme." + COMPLIANCE_STATUS)
+		assert.False(t, ok)
+	})
+
+	t.Run("json body", func(t *testing.T) {
+		logRecord, resource := createTestLogRecord()
+		logRecord.Body().SetStr("raw evidence")
+		applier := NewApplier(client, append(opts, WithFormat(FormatJSONBody))...)
+		require.NoError(t, applier.ApplyAttributes(context.Background(), resource, logRecord))
+
+		require.Equal(t, pcommon.ValueTypeMap, logRecord.Body().Type())
+		nested := logRecord.Body().Map()
+		original, ok := nested.Get("body")
+		require.True(t, ok)
+		assert.Equal(t, "raw evidence", original.Str(), "original body is kept")
+		compliance, ok := nested.Get("compliance")
+		require.True(t, ok)
+		assert.JSONEq(t, expected, compliance.Str())
+		_, ok = logRecord.Attributes().Get("acme.compliance")
+		assert.False(t, ok)
+	})
+
+	t.Run("json body keeps structured bodies", func(t *testing.T) {
+		logRecord, resource := createTestLogRecord()
+		logRecord.Body().SetEmptyMap().PutStr("result", "pass")
+		applier := NewApplier(client, append(opts, WithFormat(FormatJSONBody))...)
+		require.NoError(t, applier.ApplyAttributes(context.Background(), resource, logRecord))
+
+		assert.Equal(t, map[string]any{"result": "pass"}, logRecord.Body().Map().AsRaw()["body"])
+	})
+
+	t.Run("json body falls back to the attribute for spans", func(t *testing.T) {
+		span := ptrace.NewSpan()
+		span.Attributes().PutStr(POLICY_RULE_ID, "test-policy-123")
+		span.Attributes().PutStr(POLICY_ENGINE_NAME, "test-source")
+		span.Attributes().PutStr(POLICY_EVALUATION_RESULT, "compliant")
+		applier := NewApplier(client, append(opts, WithFormat(FormatJSONBody))...)
+		require.NoError(t, applier.ApplySpanAttributes(context.Background(), pcommon.NewResource(), span))
+
+		compliance, ok := span.Attributes().Get("acme.compliance")
+		require.True(t, ok)
+		assert.JSONEq(t, expected, compliance.Str())
+	})
+}
+
+func TestApplyAttributes_MapperID(t *testing.T) {
+	tests := []struct {
+		name     string
+		mapperId *string
+		expected string
+	}{
+		{
+			name:     "basic mapper fallback",
+			mapperId: stringPtr("basic"),
+			expected: "basic",
+		},
+		{
+			name:     "configured plugin",
+			mapperId: stringPtr("cel"),
+			expected: "cel",
+		},
+		{
+			name: "no mapper ID in response",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(EnrichmentResponse{
+					Compliance: Compliance{
+						EnrichmentStatus: ComplianceEnrichmentStatusUnmapped,
+					},
+					MapperId: tt.mapperId,
+				})
+			}))
+			defer mockServer.Close()
+
+			client, err := NewClient(mockServer.URL)
+			require.NoError(t, err)
+
+			logRecord, resource := createTestLogRecord()
+			err = ApplyAttributes(context.Background(), client, resource, logRecord)
+			require.NoError(t, err)
+
+			mapperId, ok := logRecord.Attributes().Get(COMPLIANCE_MAPPER_ID)
+			if tt.expected == "" {
+				assert.False(t, ok, "mapper ID should not be set without one in the response")
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, mapperId.Str())
+		})
+	}
+}
+
+func TestApplier_ApplyUnmappedAttributes(t *testing.T) {
+	mapperId := "basic"
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(EnrichmentResponse{
+			Compliance: Compliance{
+				Frameworks:       ComplianceFrameworks{Frameworks: []string{}, Requirements: []string{}},
+				Status:           ComplianceStatusUnknown,
+				EnrichmentStatus: ComplianceEnrichmentStatusUnmapped,
+			},
+			MapperId: &mapperId,
+		})
+	}))
+	defer mockServer.Close()
+
+	client, err := NewClient(mockServer.URL)
+	require.NoError(t, err)
+
+	// withoutLag checks the lag was written and drops it, since it differs
+	// between two records enriched one after the other.
+	withoutLag := func(t *testing.T, attrs pcommon.Map) map[string]any {
+		lag, ok := attrs.Get(COMPLIANCE_ENRICHMENT_LAG_MS)
+		require.True(t, ok)
+		assert.GreaterOrEqual(t, lag.Int(), int64(2000))
+		raw := attrs.AsRaw()
+		delete(raw, COMPLIANCE_ENRICHMENT_LAG_MS)
+		return raw
+	}
+	timestamp := pcommon.NewTimestampFromTime(time.Now().Add(-2 * time.Second))
+
+	for _, format := range []Format{FormatAttributes, FormatJSONAttribute, FormatJSONBody} {
+		t.Run(string(format), func(t *testing.T) {
+			applier := NewApplier(client, WithFormat(format))
+
+			looked, resource := createTestLogRecord()
+			looked.SetTimestamp(timestamp)
+			looked.Body().SetStr("raw evidence")
+			require.NoError(t, applier.ApplyAttributes(context.Background(), resource, looked))
+
+			known, _ := createTestLogRecord()
+			known.SetTimestamp(timestamp)
+			known.Body().SetStr("raw evidence")
+			require.NoError(t, applier.ApplyUnmappedAttributes(known, mapperId))
+
+			assert.Equal(t, withoutLag(t, looked.Attributes()), withoutLag(t, known.Attributes()))
+			assert.Equal(t, looked.Body().AsRaw(), known.Body().AsRaw())
+			assert.Equal(t, mapperId, known.Attributes().AsRaw()[COMPLIANCE_MAPPER_ID])
+		})
+	}
+
+	t.Run("span", func(t *testing.T) {
+		span := ptrace.NewSpan()
+		span.SetEndTimestamp(timestamp)
+		require.NoError(t, NewApplier(client).ApplyUnmappedSpanAttributes(span, ""))
+
+		attrs := withoutLag(t, span.Attributes())
+		assert.Equal(t, map[string]any{COMPLIANCE_ENRICHMENT_STATUS: string(ComplianceEnrichmentStatusUnmapped)}, attrs,
+			"mapper ID should not be set without one")
+	})
+}
+
+// TestApplyAttributes_PreservesTargetAttributes verifies target identity
+// survives enrichment so findings can be grouped by resource.
+func TestApplyAttributes_PreservesTargetAttributes(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(EnrichmentResponse{
+			Compliance: Compliance{
+				Control: ComplianceControl{
+					CatalogId: "NIST-800-53",
+					Category:  "Access Control",
+					Id:        "AC-1",
+				},
+				Frameworks: ComplianceFrameworks{
+					Requirements: []string{"req-1"},
+					Frameworks:   []string{"NIST-800-53"},
+				},
+				Status:           ComplianceStatusCompliant,
+				EnrichmentStatus: ComplianceEnrichmentStatusSuccess,
+			},
+		})
+	}))
+	defer mockServer.Close()
+
+	client, err := NewClient(mockServer.URL)
+	require.NoError(t, err)
+
+	logRecord, resource := createTestLogRecord()
+	attrs := logRecord.Attributes()
+	attrs.PutStr(POLICY_TARGET_ID, "scan-123")
+	attrs.PutStr(POLICY_TARGET_NAME, "weekly-repo-scan")
+	attrs.PutStr(POLICY_TARGET_TYPE, "vulnerability")
+
+	err = ApplyAttributes(context.Background(), client, resource, logRecord)
+	require.NoError(t, err)
+
+	assertAttributesEqual(t, attrs.AsRaw(), map[string]interface{}{
+		POLICY_TARGET_ID:      "scan-123",
+		POLICY_TARGET_NAME:    "weekly-repo-scan",
+		POLICY_TARGET_TYPE:    "vulnerability",
+		COMPLIANCE_CONTROL_ID: "AC-1",
+	})
+}
+
+// TestApplier_KeyMapping verifies custom keys change the emitted attribute
+// keys while the values stay identical.
+func TestApplier_KeyMapping(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(EnrichmentResponse{
+			Compliance: Compliance{
+				Control: ComplianceControl{
+					CatalogId: "NIST-800-53",
+					Category:  "Access Control",
+					Id:        "AC-1",
+				},
+				Frameworks: ComplianceFrameworks{
+					Requirements: []string{"req-1"},
+					Frameworks:   []string{"NIST-800-53"},
+				},
+				Status:           ComplianceStatusCompliant,
+				EnrichmentStatus: ComplianceEnrichmentStatusSuccess,
+			},
+		})
+	}))
+	defer mockServer.Close()
+
+	client, err := NewClient(mockServer.URL)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		opts     []ApplierOption
+		expected map[string]interface{}
+	}{
+		{
+			name: "default keys",
+			expected: map[string]interface{}{
+				COMPLIANCE_STATUS:            "Compliant",
+				COMPLIANCE_CONTROL_ID:        "AC-1",
+				COMPLIANCE_ENRICHMENT_STATUS: "Success",
+			},
+		},
+		{
+			name: "prefixed keys",
+			opts: []ApplierOption{WithKeyPrefix("acme.")},
+			expected: map[string]interface{}{
+				"acme." + COMPLIANCE_STATUS:            "Compliant",
+				"acme." + COMPLIANCE_CONTROL_ID:        "AC-1",
+				"acme." + COMPLIANCE_ENRICHMENT_STATUS: "Success",
+			},
+		},
+		{
+			name: "overrides take precedence over prefix",
+			opts: []ApplierOption{
+				WithKeyPrefix("acme."),
+				WithKeyOverrides(map[string]string{COMPLIANCE_CONTROL_ID: "control"}),
+			},
+			expected: map[string]interface{}{
+				"acme." + COMPLIANCE_STATUS: "Compliant",
+				"control":                   "AC-1",
+			},
+		},
+		{
+			name: "key mapping",
+			opts: []ApplierOption{
+				WithKeyMapping(KeyMapping{
+					Prefix:    "acme.",
+					Overrides: map[string]string{COMPLIANCE_CONTROL_ID: "control"},
+				}),
+			},
+			expected: map[string]interface{}{
+				"acme." + COMPLIANCE_STATUS: "Compliant",
+				"control":                   "AC-1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logRecord, resource := createTestLogRecord()
+
+			err := NewApplier(client, tt.opts...).ApplyAttributes(context.Background(), resource, logRecord)
+			require.NoError(t, err)
+
+			raw := logRecord.Attributes().AsRaw()
+			assertAttributesEqual(t, raw, tt.expected)
+			if len(tt.opts) > 0 {
+				assert.NotContains(t, raw, COMPLIANCE_STATUS)
+				assert.NotContains(t, raw, COMPLIANCE_CONTROL_ID)
+			}
+		})
+	}
+}
+
+func TestApplyAttributes_PolicyRuleName(t *testing.T) {
+	tests := []struct {
+		name         string
+		ruleName     string
+		expectedName *string
+	}{
+		{
+			name:         "rule name is sent when present",
+			ruleName:     "Deny root user",
+			expectedName: stringPtr("Deny root user"),
+		},
+		{
+			name: "rule name is omitted when absent",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received EnrichmentRequest
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(EnrichmentResponse{
+					Compliance: Compliance{EnrichmentStatus: ComplianceEnrichmentStatusUnmapped},
+				})
+			}))
+			defer mockServer.Close()
+
+			client, err := NewClient(mockServer.URL)
+			require.NoError(t, err)
+
+			logRecord, resource := createTestLogRecord()
+			if tt.ruleName != "" {
+				logRecord.Attributes().PutStr(POLICY_RULE_NAME, tt.ruleName)
+			}
+
+			require.NoError(t, client.ApplyAttributes(context.Background(), resource, logRecord))
+			assert.Equal(t, tt.expectedName, received.Evidence.PolicyRuleName)
+		})
+	}
+}
+
+func TestApplyAttributes_CheckCounts(t *testing.T) {
+	intPtr := func(n int) *int { return &n }
+
+	tests := []struct {
+		name           string
+		setAttrs       func(attrs pcommon.Map)
+		expectedPassed *int
+		expectedFailed *int
+	}{
+		{
+			name: "counts are sent when present",
+			setAttrs: func(attrs pcommon.Map) {
+				attrs.PutInt(POLICY_EVALUATION_CHECKS_PASSED, 8)
+				attrs.PutInt(POLICY_EVALUATION_CHECKS_FAILED, 2)
+			},
+			expectedPassed: intPtr(8),
+			expectedFailed: intPtr(2),
+		},
+		{
+			name:     "counts are omitted when absent",
+			setAttrs: func(pcommon.Map) {},
+		},
+		{
+			name: "invalid counts are omitted",
+			setAttrs: func(attrs pcommon.Map) {
+				attrs.PutStr(POLICY_EVALUATION_CHECKS_PASSED, "8")
+				attrs.PutInt(POLICY_EVALUATION_CHECKS_FAILED, -1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received EnrichmentRequest
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(EnrichmentResponse{
+					Compliance: Compliance{EnrichmentStatus: ComplianceEnrichmentStatusUnmapped},
+				})
+			}))
+			defer mockServer.Close()
+
+			client, err := NewClient(mockServer.URL)
+			require.NoError(t, err)
+
+			logRecord, resource := createTestLogRecord()
+			tt.setAttrs(logRecord.Attributes())
+
+			require.NoError(t, client.ApplyAttributes(context.Background(), resource, logRecord))
+			assert.Equal(t, tt.expectedPassed, received.Evidence.ChecksPassed)
+			assert.Equal(t, tt.expectedFailed, received.Evidence.ChecksFailed)
+		})
+	}
+}
+
+func TestApplyAttributes_RiskScore(t *testing.T) {
+	tests := []struct {
+		name     string
+		risk     *ComplianceRisk
+		expected *float64
+	}{
+		{
+			name:     "risk score is emitted when scored",
+			risk:     &ComplianceRisk{Score: float64Ptr(75.5)},
+			expected: float64Ptr(75.5),
+		},
+		{
+			name: "risk score is omitted when not scored",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(EnrichmentResponse{
+					Compliance: Compliance{
+						EnrichmentStatus: ComplianceEnrichmentStatusSuccess,
+						Status:           ComplianceStatusNonCompliant,
+						Risk:             tt.risk,
+					},
+				})
+			}))
+			defer mockServer.Close()
+
+			client, err := NewClient(mockServer.URL)
+			require.NoError(t, err)
+
+			logRecord, resource := createTestLogRecord()
+			require.NoError(t, client.ApplyAttributes(context.Background(), resource, logRecord))
+
+			score, ok := logRecord.Attributes().Get(COMPLIANCE_RISK_SCORE)
+			if tt.expected == nil {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, *tt.expected, score.Double())
+		})
+	}
+}
+
 func assertAttributesEqual(t *testing.T, attrs map[string]interface{}, expected map[string]interface{}) {
 	t.Helper()
 	assert.Subset(t, attrs, expected)
@@ -278,6 +1221,10 @@ func stringPtr(s string) *string {
 	return &s
 }
 
+func float64Ptr(f float64) *float64 {
+	return &f
+}
+
 // createTestLogRecord is a helper function for easy test setup
 func createTestLogRecord() (plog.LogRecord, pcommon.Resource) {
 	logRecord := plog.NewLogRecord()
@@ -289,3 +1236,196 @@ func createTestLogRecord() (plog.LogRecord, pcommon.Resource) {
 	resource := pcommon.NewResource()
 	return logRecord, resource
 }
+
+func TestApplySpanAttributes(t *testing.T) {
+	traceID := pcommon.TraceID([16]byte{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36})
+	spanID := pcommon.SpanID([8]byte{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7})
+	start := time.Date(2025, 1, 5, 12, 30, 0, 0, time.UTC)
+	end := start.Add(2 * time.Second)
+
+	tests := []struct {
+		name              string
+		setTimestamps     func(span ptrace.Span)
+		expectedTimestamp time.Time
+	}{
+		{
+			name: "end time is the evidence time",
+			setTimestamps: func(span ptrace.Span) {
+				span.SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+				span.SetEndTimestamp(pcommon.NewTimestampFromTime(end))
+			},
+			expectedTimestamp: end,
+		},
+		{
+			name: "start time is used for a span without an end time",
+			setTimestamps: func(span ptrace.Span) {
+				span.SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+			},
+			expectedTimestamp: start,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received EnrichmentRequest
+			var traceparent string
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				traceparent = r.Header.Get("traceparent")
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(EnrichmentResponse{
+					Compliance: Compliance{
+						Control: ComplianceControl{
+							Id:        "OSPS-QA-07.01",
+							CatalogId: "OSPS-B",
+							Category:  "Quality Assurance",
+						},
+						Frameworks: ComplianceFrameworks{
+							Frameworks:   []string{"NIST-800-53"},
+							Requirements: []string{"CM-3"},
+						},
+						Status:           ComplianceStatusNonCompliant,
+						EnrichmentStatus: ComplianceEnrichmentStatusSuccess,
+					},
+				})
+			}))
+			defer mockServer.Close()
+
+			client, err := NewClient(mockServer.URL)
+			require.NoError(t, err)
+
+			span := ptrace.NewSpan()
+			span.SetName("policy.evaluate")
+			span.SetTraceID(traceID)
+			span.SetSpanID(spanID)
+			span.SetFlags(uint32(trace.FlagsSampled))
+			tt.setTimestamps(span)
+			span.Attributes().PutStr(POLICY_RULE_ID, "github_branch_protection")
+			span.Attributes().PutStr(POLICY_ENGINE_NAME, "conforma")
+			span.Attributes().PutStr(POLICY_EVALUATION_RESULT, "Failed")
+
+			require.NoError(t, client.ApplySpanAttributes(context.Background(), pcommon.NewResource(), span))
+
+			assert.Equal(t, "github_branch_protection", received.Evidence.PolicyRuleId)
+			assert.Equal(t, "conforma", received.Evidence.PolicyEngineName)
+			assert.True(t, tt.expectedTimestamp.Equal(received.Evidence.Timestamp), "got timestamp %s", received.Evidence.Timestamp)
+			assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", traceparent)
+
+			attrs := span.Attributes().AsRaw()
+			assert.Equal(t, string(ComplianceEnrichmentStatusSuccess), attrs[COMPLIANCE_ENRICHMENT_STATUS])
+			assert.Equal(t, string(ComplianceStatusNonCompliant), attrs[COMPLIANCE_STATUS])
+			assert.Equal(t, "OSPS-QA-07.01", attrs[COMPLIANCE_CONTROL_ID])
+			assert.Equal(t, []any{"NIST-800-53"}, attrs[COMPLIANCE_FRAMEWORKS])
+		})
+	}
+}
+
+// benchmarkResponse is a compass response mapped to several frameworks, as
+// returned for a typical policy rule.
+var benchmarkResponse = EnrichmentResponse{
+	Compliance: Compliance{
+		Control: ComplianceControl{
+			Id:                     "OSPS-AC-03.01",
+			CatalogId:              "OSPS-B",
+			Category:               "Access Control",
+			RemediationDescription: stringPtr("Require at least one approval before merging to the main branch"),
+		},
+		Frameworks: ComplianceFrameworks{
+			Frameworks:   []string{"NIST-800-53", "ISO-27001", "SOC-2", "PCI-DSS"},
+			Requirements: []string{"AC-2.1", "AC-2.2", "AC-3", "A.9.1.1", "CC6.1", "7.2.1"},
+		},
+		Status:           ComplianceStatusNonCompliant,
+		EnrichmentStatus: ComplianceEnrichmentStatusSuccess,
+		Risk:             &ComplianceRisk{Score: float64Ptr(80)},
+	},
+}
+
+// staticDoer answers every request with the same JSON body without any
+// network round trip, isolating the cost of the applier itself.
+type staticDoer struct {
+	body []byte
+}
+
+func (d staticDoer) Do(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+		_ = req.Body.Close()
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(d.body)),
+	}, nil
+}
+
+// createBenchmarkLogRecord returns a log record carrying the attributes
+// proofwatch emits for OCSF evidence.
+func createBenchmarkLogRecord() plog.LogRecord {
+	logRecord := plog.NewLogRecord()
+	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	logRecord.Body().SetStr(`{"class_uid": 6007, "status": "failure", "policy": {"uid": "branch-protection"}}`)
+	attrs := logRecord.Attributes()
+	attrs.PutStr(POLICY_RULE_ID, "branch-protection")
+	attrs.PutStr(POLICY_RULE_NAME, "Branch protection")
+	attrs.PutStr(POLICY_RULE_URI, "github.com/org/policies/b8a7c2e")
+	attrs.PutStr(POLICY_ENGINE_NAME, "OPA")
+	attrs.PutStr(POLICY_ENGINE_VERSION, "v0.68.0")
+	attrs.PutStr(POLICY_EVALUATION_RESULT, "Failed")
+	attrs.PutStr(POLICY_EVALUATION_MESSAGE, "Branch main does not require reviews")
+	attrs.PutStr(POLICY_TARGET_ID, "github.com/org/repo")
+	attrs.PutStr(POLICY_TARGET_NAME, "repo")
+	attrs.PutStr(POLICY_TARGET_TYPE, "repository")
+	attrs.PutStr(POLICY_TARGET_ENVIRONMENT, "production")
+	attrs.PutStr(COMPLIANCE_REMEDIATION_ACTION, "Unknown")
+	attrs.PutStr(COMPLIANCE_REMEDIATION_STATUS, "Skipped")
+	attrs.PutStr(COMPLIANCE_ASSESSMENT_ID, "assessment-2025-01")
+	attrs.PutStr(EVIDENCE_COLLECTOR_NAME, "proofwatch-ci")
+	attrs.PutStr("host.name", "runner-1")
+	attrs.PutStr("service.name", "policy-scanner")
+	attrs.PutStr("trace_id", "4bf92f3577b34da6a3ce929d0e0e4736")
+	return logRecord
+}
+
+// BenchmarkApplyAttributes measures enriching one log record, from reading
+// the lookup attributes to writing the compliance attributes. Each iteration
+// starts from a copy of the same record so attributes do not accumulate.
+func BenchmarkApplyAttributes(b *testing.B) {
+	body, err := json.Marshal(benchmarkResponse)
+	require.NoError(b, err)
+
+	inProcess, err := NewClient("http://compass.test", WithHTTPClient(staticDoer{body: body}))
+	require.NoError(b, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+	overHTTP, err := NewClient(server.URL)
+	require.NoError(b, err)
+
+	benchmarks := []struct {
+		name    string
+		applier *Applier
+	}{
+		{name: "in-process", applier: NewApplier(inProcess)},
+		{name: "in-process with key prefix", applier: NewApplier(inProcess, WithKeyPrefix("beacon."))},
+		{name: "http", applier: NewApplier(overHTTP)},
+	}
+
+	template := createBenchmarkLogRecord()
+	resource := pcommon.NewResource()
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			ctx := context.Background()
+			logRecord := plog.NewLogRecord()
+			b.ReportAllocs()
+			for b.Loop() {
+				template.CopyTo(logRecord)
+				if err := bm.applier.ApplyAttributes(ctx, resource, logRecord); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -8,21 +8,36 @@ const COMPLIANCE_ASSESSMENT_ID = "compliance.assessment.id"
 // Environments or contexts where this control applies
 const COMPLIANCE_CONTROL_APPLICABILITY = "compliance.control.applicability"
 
+// Documentation of the assessment procedure that evaluated the policy rule, as written in the evaluation plan
+const COMPLIANCE_CONTROL_ASSESSMENT_PROCEDURE = "compliance.control.assessment.procedure"
+
 // Unique identifier for the security control catalog or framework
 const COMPLIANCE_CONTROL_CATALOG_ID = "compliance.control.catalog.id"
 
+// Version or revision of the security control catalog used for the mapping
+const COMPLIANCE_CONTROL_CATALOG_VERSION = "compliance.control.catalog.version"
+
 // Category or family that the security control belongs to
 const COMPLIANCE_CONTROL_CATEGORY = "compliance.control.category"
 
 // Unique identifier for the security control and assessment requirement being assessed
 const COMPLIANCE_CONTROL_ID = "compliance.control.id"
 
+// Parameter values of the security control, keyed by parameter ID. Used to report organization-defined values, such as a review frequency, with the finding
+const COMPLIANCE_CONTROL_PARAMETERS = "compliance.control.parameters"
+
+// Time in milliseconds between the evidence timestamp and its enrichment. Used to detect backlog in the compliance pipeline
+const COMPLIANCE_ENRICHMENT_LAG_MS = "compliance.enrichment.lag_ms"
+
 // Result of the compliance framework mapping and enrichment process, indicating whether compliance context was successfully added to the event
 const COMPLIANCE_ENRICHMENT_STATUS = "compliance.enrichment.status"
 
 // Regulatory or industry standards being evaluated for compliance
 const COMPLIANCE_FRAMEWORKS = "compliance.frameworks"
 
+// ID of the mapper plugin that produced the compliance context. Used to tell a configured plugin apart from the basic mapper fallback
+const COMPLIANCE_MAPPER_ID = "compliance.mapper.id"
+
 // Remediation action determined by the policy engine in response to the compliance assessment result
 const COMPLIANCE_REMEDIATION_ACTION = "compliance.remediation.action"
 
@@ -44,15 +59,27 @@ const COMPLIANCE_REQUIREMENTS = "compliance.requirements"
 // Severity classification of the risk posed by non-compliance with the control requirement
 const COMPLIANCE_RISK_LEVEL = "compliance.risk.level"
 
+// Relative risk of the result on a scale from 0 to 100, weighted by the importance of the impacted frameworks and the criticality of the control
+const COMPLIANCE_RISK_SCORE = "compliance.risk.score"
+
 // Overall compliance determination for the assessed resource or control, indicating whether it meets the compliance requirements
 const COMPLIANCE_STATUS = "compliance.status"
 
+// Name of the collector instance or pipeline stage that emitted the evidence record. Used to trace evidence through fan-in pipelines
+const EVIDENCE_COLLECTOR_NAME = "evidence.collector.name"
+
 // Name of the policy engine that performed the evaluation or enforcement action
 const POLICY_ENGINE_NAME = "policy.engine.name"
 
 // Version of the policy engine
 const POLICY_ENGINE_VERSION = "policy.engine.version"
 
+// Number of checks within the policy rule that failed, for policy engines that report per-check results
+const POLICY_EVALUATION_CHECKS_FAILED = "policy.evaluation.checks.failed"
+
+// Number of checks within the policy rule that passed, for policy engines that report per-check results
+const POLICY_EVALUATION_CHECKS_PASSED = "policy.evaluation.checks.passed"
+
 // Additional context about the policy evaluation result
 const POLICY_EVALUATION_MESSAGE = "policy.evaluation.message"
 
@@ -74,6 +101,9 @@ const POLICY_TARGET_ENVIRONMENT = "policy.target.environment"
 // Unique identifier for the resource or entity being evaluated or enforced against
 const POLICY_TARGET_ID = "policy.target.id"
 
+// Identifiers of all resources or entities evaluated or enforced against when the evidence covers more than one target. The first entry is the primary target reported as policy.target.id
+const POLICY_TARGET_IDS = "policy.target.ids"
+
 // Human-readable name of the resource or entity being evaluated or enforced against
 const POLICY_TARGET_NAME = "policy.target.name"
 
@@ -0,0 +1,114 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned while the circuit breaker is short-circuiting
+// requests to compass.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// CircuitBreaker stops sending requests after a run of consecutive failures,
+// so a hard-down compass does not add latency to every log record. Once the
+// cooldown has passed, a single probe request is let through: success closes
+// the circuit, failure opens it for another cooldown.
+type CircuitBreaker struct {
+	doer      HttpRequestDoer
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	state    circuitState
+	failures int
+	openedAt time.Time
+}
+
+var _ HttpRequestDoer = (*CircuitBreaker)(nil)
+
+// NewCircuitBreaker wraps doer with a breaker that opens after threshold
+// consecutive failures and stays open for cooldown. Only connection failures
+// and responses saying compass is unavailable count as failures; see
+// unavailable.
+func NewCircuitBreaker(doer HttpRequestDoer, threshold int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		doer:      doer,
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+func (b *CircuitBreaker) Do(req *http.Request) (*http.Response, error) {
+	if !b.allow() {
+		return nil, ErrCircuitOpen
+	}
+	resp, err := b.doer.Do(req)
+	b.record(!unavailable(resp, err))
+	return resp, err
+}
+
+// unavailable reports whether a request outcome means compass cannot be
+// reached: a transport error or a 502, 503, or 504 response. Any other
+// response, including a 500 for evidence compass failed to enrich, shows that
+// compass is up and answering.
+func unavailable(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// allow reports whether a request may be sent, moving an open circuit to
+// half-open once the cooldown has passed.
+func (b *CircuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case circuitOpen:
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			return false
+		}
+		b.state = circuitHalfOpen
+		return true
+	case circuitHalfOpen:
+		// Only the probe request is let through.
+		return false
+	default:
+		return true
+	}
+}
+
+// record updates the breaker with the outcome of a request.
+func (b *CircuitBreaker) record(success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if success {
+		b.state = circuitClosed
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.state == circuitHalfOpen || b.failures >= b.threshold {
+		b.state = circuitOpen
+		b.openedAt = b.now()
+	}
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreakerTransitions(t *testing.T) {
+	var healthy atomic.Bool
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	now := time.Now()
+	breaker := NewCircuitBreaker(http.DefaultClient, 2, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	send := func() error {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		resp, err := breaker.Do(req)
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	}
+
+	// Closed: failures below the threshold still reach the server.
+	require.NoError(t, send())
+	assert.Equal(t, circuitClosed, breaker.state)
+	require.NoError(t, send())
+	assert.Equal(t, circuitOpen, breaker.state, "breaker should open at the failure threshold")
+	assert.Equal(t, int32(2), hits.Load())
+
+	// Open: requests are short-circuited until the cooldown passes.
+	assert.True(t, errors.Is(send(), ErrCircuitOpen))
+	assert.Equal(t, int32(2), hits.Load())
+
+	// Half-open: a failed probe reopens the circuit.
+	now = now.Add(time.Minute)
+	require.NoError(t, send())
+	assert.Equal(t, int32(3), hits.Load())
+	assert.Equal(t, circuitOpen, breaker.state)
+	assert.True(t, errors.Is(send(), ErrCircuitOpen))
+
+	// Half-open: a successful probe closes the circuit.
+	now = now.Add(time.Minute)
+	healthy.Store(true)
+	require.NoError(t, send())
+	assert.Equal(t, circuitClosed, breaker.state)
+	assert.Equal(t, 0, breaker.failures)
+	require.NoError(t, send())
+	assert.Equal(t, int32(5), hits.Load())
+}
+
+func TestCircuitBreakerCountsOnlyUnavailable(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		expected circuitState
+	}{
+		{name: "bad gateway", status: http.StatusBadGateway, expected: circuitOpen},
+		{name: "service unavailable", status: http.StatusServiceUnavailable, expected: circuitOpen},
+		{name: "gateway timeout", status: http.StatusGatewayTimeout, expected: circuitOpen},
+		{name: "enrichment failed", status: http.StatusInternalServerError, expected: circuitClosed},
+		{name: "bad request", status: http.StatusBadRequest, expected: circuitClosed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			breaker := NewCircuitBreaker(http.DefaultClient, 1, time.Minute)
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+			require.NoError(t, err)
+			resp, err := breaker.Do(req)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+
+			assert.Equal(t, tt.expected, breaker.state)
+		})
+	}
+}
+
+func TestCircuitBreakerHalfOpenAllowsSingleProbe(t *testing.T) {
+	now := time.Now()
+	breaker := NewCircuitBreaker(http.DefaultClient, 1, time.Second)
+	breaker.now = func() time.Time { return now }
+
+	breaker.record(false)
+	require.Equal(t, circuitOpen, breaker.state)
+
+	now = now.Add(time.Second)
+	assert.True(t, breaker.allow(), "first request after cooldown should probe")
+	assert.Equal(t, circuitHalfOpen, breaker.state)
+	assert.False(t, breaker.allow(), "concurrent requests should be short-circuited while probing")
+}
+
+func TestCircuitBreakerShortCircuitsEnrichment(t *testing.T) {
+	down := httptest.NewServer(http.NotFoundHandler())
+	downURL := down.URL
+	down.Close()
+
+	breaker := NewCircuitBreaker(http.DefaultClient, 1, time.Minute)
+	client, err := NewClient(downURL, WithHTTPClient(breaker))
+	require.NoError(t, err)
+
+	logRecord, resource := createTestLogRecord()
+	err = client.ApplyAttributes(context.Background(), resource, logRecord)
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, ErrCircuitOpen))
+
+	err = client.ApplyAttributes(context.Background(), resource, logRecord)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+}
@@ -13,6 +13,8 @@ import (
 	"net/url"
 	"strings"
 	"time"
+
+	"github.com/oapi-codegen/runtime"
 )
 
 // Defines values for ComplianceEnrichmentStatus.
@@ -24,10 +26,21 @@ const (
 	ComplianceEnrichmentStatusUnmapped ComplianceEnrichmentStatus = "Unmapped"
 )
 
+// Defines values for ComplianceRemediationAction.
+const (
+	ComplianceRemediationActionAllow     ComplianceRemediationAction = "Allow"
+	ComplianceRemediationActionBlock     ComplianceRemediationAction = "Block"
+	ComplianceRemediationActionNotify    ComplianceRemediationAction = "Notify"
+	ComplianceRemediationActionRemediate ComplianceRemediationAction = "Remediate"
+	ComplianceRemediationActionUnknown   ComplianceRemediationAction = "Unknown"
+	ComplianceRemediationActionWaive     ComplianceRemediationAction = "Waive"
+)
+
 // Defines values for ComplianceStatus.
 const (
 	ComplianceStatusCompliant     ComplianceStatus = "Compliant"
 	ComplianceStatusExempt        ComplianceStatus = "Exempt"
+	ComplianceStatusNeedsReview   ComplianceStatus = "Needs Review"
 	ComplianceStatusNonCompliant  ComplianceStatus = "Non-Compliant"
 	ComplianceStatusNotApplicable ComplianceStatus = "Not Applicable"
 	ComplianceStatusUnknown       ComplianceStatus = "Unknown"
@@ -44,25 +57,49 @@ const (
 
 // Defines values for EvidencePolicyEvaluationStatus.
 const (
-	Failed        EvidencePolicyEvaluationStatus = "Failed"
-	NeedsReview   EvidencePolicyEvaluationStatus = "Needs Review"
-	NotApplicable EvidencePolicyEvaluationStatus = "Not Applicable"
-	NotRun        EvidencePolicyEvaluationStatus = "Not Run"
-	Passed        EvidencePolicyEvaluationStatus = "Passed"
-	Unknown       EvidencePolicyEvaluationStatus = "Unknown"
+	EvidencePolicyEvaluationStatusFailed        EvidencePolicyEvaluationStatus = "Failed"
+	EvidencePolicyEvaluationStatusNeedsReview   EvidencePolicyEvaluationStatus = "Needs Review"
+	EvidencePolicyEvaluationStatusNotApplicable EvidencePolicyEvaluationStatus = "Not Applicable"
+	EvidencePolicyEvaluationStatusNotRun        EvidencePolicyEvaluationStatus = "Not Run"
+	EvidencePolicyEvaluationStatusPassed        EvidencePolicyEvaluationStatus = "Passed"
+	EvidencePolicyEvaluationStatusUnknown       EvidencePolicyEvaluationStatus = "Unknown"
 )
 
+// AttributeDefinition A telemetry attribute emitted by complybeacon
+type AttributeDefinition struct {
+	// Description Meaning of the attribute
+	Description string `json:"description"`
+
+	// Key Attribute key
+	Key string `json:"key"`
+}
+
+// CatalogProcedures Procedures loaded for a single catalog
+type CatalogProcedures struct {
+	// CatalogId Unique identifier for the control catalog
+	CatalogId string `json:"catalogId"`
+
+	// Procedures Loaded procedures in sorted ID order
+	Procedures []ProcedureMapping `json:"procedures"`
+}
+
 // Compliance Compliance details from OCSF Security Control Profile.
 type Compliance struct {
 	// Control Security control information for compliance assessment
 	Control ComplianceControl `json:"control"`
 
+	// Controls All security controls matched by the policy rule when results are aggregated across catalogs. The first entry is the primary control.
+	Controls *[]ComplianceControl `json:"controls,omitempty"`
+
 	// EnrichmentStatus Status of the compliance enrichment process: Success, Unmapped, Partial, Unknown, or Skipped.
 	EnrichmentStatus ComplianceEnrichmentStatus `json:"enrichmentStatus"`
 
 	// Frameworks Compliance framework and requirement information
 	Frameworks ComplianceFrameworks `json:"frameworks"`
 
+	// RemediationAction Remediation action recommended by the catalog for the mapped control
+	RemediationAction *ComplianceRemediationAction `json:"remediationAction,omitempty"`
+
 	// Risk Compliance risk assessment information
 	Risk *ComplianceRisk `json:"risk,omitempty"`
 
@@ -73,6 +110,9 @@ type Compliance struct {
 // ComplianceEnrichmentStatus Status of the compliance enrichment process: Success, Unmapped, Partial, Unknown, or Skipped.
 type ComplianceEnrichmentStatus string
 
+// ComplianceRemediationAction Remediation action recommended by the catalog for the mapped control
+type ComplianceRemediationAction string
+
 // ComplianceStatus Compliance status
 type ComplianceStatus string
 
@@ -81,17 +121,29 @@ type ComplianceControl struct {
 	// Applicability Environments or contexts where this control applies
 	Applicability *[]string `json:"applicability,omitempty"`
 
+	// AssessmentProcedure Documentation of the assessment procedure that evaluated the policy rule, as written in the evaluation plan
+	AssessmentProcedure *string `json:"assessmentProcedure,omitempty"`
+
 	// CatalogId Unique identifier for the security control catalog or framework
 	CatalogId string `json:"catalogId"`
 
+	// CatalogVersion Version or revision of the security control catalog used for the mapping
+	CatalogVersion *string `json:"catalogVersion,omitempty"`
+
 	// Category Category or family that the security control belongs to
 	Category string `json:"category"`
 
 	// Id Unique identifier for the security control being assessed
 	Id string `json:"id"`
 
-	// RemediationDescription Description of the recommended remediation strategy for this control
+	// Parameters Parameter values of the security control, keyed by parameter ID
+	Parameters *map[string]string `json:"parameters,omitempty"`
+
+	// RemediationDescription Description of the recommended remediation strategy for this control, from the recommendation of the assessment requirement in the catalog
 	RemediationDescription *string `json:"remediationDescription,omitempty"`
+
+	// Title Human-readable title of the security control, or of the policy rule when the catalog has none
+	Title *string `json:"title,omitempty"`
 }
 
 // ComplianceFrameworks Compliance framework and requirement information
@@ -107,13 +159,66 @@ type ComplianceFrameworks struct {
 type ComplianceRisk struct {
 	// Level Risk level associated with non-compliance
 	Level *ComplianceRiskLevel `json:"level,omitempty"`
+
+	// Score Relative risk score from 0 to 100, weighted by framework importance and control criticality
+	Score *float64 `json:"score,omitempty"`
 }
 
 // ComplianceRiskLevel Risk level associated with non-compliance
 type ComplianceRiskLevel string
 
+// ControlRule A policy rule resolved to a control by a mapper
+type ControlRule struct {
+	// CatalogId Unique identifier for the control catalog
+	CatalogId string `json:"catalogId"`
+
+	// Mapper Mapper plugin ID
+	Mapper string `json:"mapper"`
+
+	// PolicyRuleId Policy rule ID
+	PolicyRuleId string `json:"policyRuleId"`
+}
+
+// ControlRulesResponse Policy rules that assess a control
+type ControlRulesResponse struct {
+	// ControlId Catalog control ID
+	ControlId string `json:"controlId"`
+
+	// Rules Policy rules in sorted mapper, catalog, and rule order
+	Rules []ControlRule `json:"rules"`
+}
+
+// CoverageResponse Policy coverage of the controls in a catalog
+type CoverageResponse struct {
+	// CatalogId Unique identifier for the control catalog
+	CatalogId string `json:"catalogId"`
+
+	// CoveragePercent Share of the catalog's controls that are covered, rounded to one decimal
+	CoveragePercent float64 `json:"coveragePercent"`
+
+	// CoveredControls Sorted IDs of controls assessed by at least one policy rule
+	CoveredControls []string `json:"coveredControls"`
+
+	// UncoveredControls Sorted IDs of controls no policy rule assesses
+	UncoveredControls []string `json:"uncoveredControls"`
+}
+
+// EnginesResponse Policy engines with registered mappers
+type EnginesResponse struct {
+	// Engines Policy engine names handled by a dedicated mapper, in sorted order
+	Engines []string `json:"engines"`
+}
+
 // EnrichmentRequest Request payload for telemetry attribute enrichment
 type EnrichmentRequest struct {
+	// Catalogs Only map the evidence against the catalogs with these IDs, e.g. the catalogs of a single
+	// tenant. Unknown catalog IDs are rejected. When omitted or empty, every loaded catalog is used.
+	Catalogs *[]string `json:"catalogs,omitempty"`
+
+	// DisableFallback Only use the mapper registered for the policy engine. Fallback mappers are skipped, and
+	// evidence from an engine without a registered mapper is reported as unmapped.
+	DisableFallback *bool `json:"disableFallback,omitempty"`
+
 	// Evidence Complete evidence log from policy engines and compliance assessment tools
 	Evidence Evidence `json:"evidence"`
 }
@@ -122,6 +227,9 @@ type EnrichmentRequest struct {
 type EnrichmentResponse struct {
 	// Compliance Compliance details from OCSF Security Control Profile.
 	Compliance Compliance `json:"compliance"`
+
+	// MapperId ID of the mapper plugin that produced the compliance result, such as basic when no plugin is configured for the policy engine.
+	MapperId *string `json:"mapperId,omitempty"`
 }
 
 // Error defines model for Error.
@@ -131,10 +239,19 @@ type Error struct {
 
 	// Message Error message
 	Message string `json:"message"`
+
+	// Reason Machine-readable reason for the error, such as INVALID_BODY, BODY_TOO_LARGE, ENGINE_NOT_FOUND, or ENRICHMENT_FAILED
+	Reason *string `json:"reason,omitempty"`
 }
 
 // Evidence Complete evidence log from policy engines and compliance assessment tools
 type Evidence struct {
+	// ChecksFailed Number of checks within the policy rule that failed
+	ChecksFailed *int `json:"checksFailed,omitempty"`
+
+	// ChecksPassed Number of checks within the policy rule that passed, for policy engines that report per-check results. When check counts are present, the compliance status is derived from them rather than from policyEvaluationStatus.
+	ChecksPassed *int `json:"checksPassed,omitempty"`
+
 	// PolicyEngineName Name of the policy engine that performed the evaluation or enforcement action
 	PolicyEngineName string `json:"policyEngineName"`
 
@@ -144,6 +261,9 @@ type Evidence struct {
 	// PolicyRuleId Unique identifier for the policy rule being evaluated or enforced
 	PolicyRuleId string `json:"policyRuleId"`
 
+	// PolicyRuleName Human-readable name of the policy rule being evaluated or enforced
+	PolicyRuleName *string `json:"policyRuleName,omitempty"`
+
 	// RawData Raw JSON output from the policy engine
 	RawData *map[string]interface{} `json:"rawData,omitempty"`
 
@@ -154,9 +274,116 @@ type Evidence struct {
 // EvidencePolicyEvaluationStatus Result of the policy evaluation
 type EvidencePolicyEvaluationStatus string
 
+// FrameworkSummary Compliance status counts for a single framework
+type FrameworkSummary struct {
+	// Counts Number of evidence results by compliance status
+	Counts map[string]int `json:"counts"`
+
+	// Framework Regulatory or industry standard the counts apply to
+	Framework string `json:"framework"`
+
+	// Total Number of evidence results mapped to the framework
+	Total int `json:"total"`
+}
+
+// MapperPlans Procedures loaded by a single mapper
+type MapperPlans struct {
+	// Catalogs Procedures per catalog in sorted catalog ID order
+	Catalogs []CatalogProcedures `json:"catalogs"`
+
+	// Mapper Mapper plugin ID
+	Mapper string `json:"mapper"`
+}
+
+// PlansResponse Procedures loaded by each mapper, as used to resolve policy rules
+type PlansResponse struct {
+	// Mappers Mappers in sorted ID order
+	Mappers []MapperPlans `json:"mappers"`
+}
+
+// ProcedureMapping Control and requirement a procedure resolves to
+type ProcedureMapping struct {
+	// ControlId Catalog control ID the procedure assesses
+	ControlId string `json:"controlId"`
+
+	// Id Procedure ID, matched against the evidence policy rule ID
+	Id string `json:"id"`
+
+	// RequirementId Requirement ID reported for the procedure
+	RequirementId string `json:"requirementId"`
+}
+
+// ReloadResponse Result of reloading the control catalogs
+type ReloadResponse struct {
+	// Catalogs Number of control catalogs loaded
+	Catalogs int `json:"catalogs"`
+}
+
+// RulesResponse Policy rules compass can map
+type RulesResponse struct {
+	// Complete Whether every mapper listed its rules
+	Complete bool `json:"complete"`
+
+	// Engines Policy rule IDs, in sorted order, mapped by the mapper registered for each policy engine
+	Engines map[string][]string `json:"engines"`
+
+	// FallbackRules Policy rule IDs, in sorted order, mapped by the fallback mappers for evidence from any engine
+	FallbackRules []string `json:"fallbackRules"`
+
+	// UnmappedMapperId Mapper plugin ID reported for evidence from other policy engines that no mapper maps. Omitted when strict engine checking rejects that evidence.
+	UnmappedMapperId *string `json:"unmappedMapperId,omitempty"`
+
+	// UnmappedMapperIds Mapper plugin ID reported for evidence from each policy engine with a registered mapper that no mapper maps
+	UnmappedMapperIds *map[string]string `json:"unmappedMapperIds,omitempty"`
+}
+
+// SchemaResponse Telemetry attribute catalog
+type SchemaResponse struct {
+	// Attributes Attribute definitions in sorted key order
+	Attributes []AttributeDefinition `json:"attributes"`
+}
+
+// SummaryRequest Batch of evidence to summarize
+type SummaryRequest struct {
+	// Catalogs Only map the evidence against the catalogs with these IDs, e.g. the catalogs of a single
+	// tenant. Unknown catalog IDs are rejected. When omitted or empty, every loaded catalog is used.
+	Catalogs *[]string `json:"catalogs,omitempty"`
+
+	// DisableFallback Only use the mapper registered for each policy engine. Fallback mappers are skipped, and
+	// evidence from an engine without a registered mapper is counted as unmapped.
+	DisableFallback *bool `json:"disableFallback,omitempty"`
+
+	// Evidence Evidence logs from policy engines and compliance assessment tools
+	Evidence []Evidence `json:"evidence"`
+}
+
+// SummaryResponse Compliance status counts grouped by framework
+type SummaryResponse struct {
+	// Frameworks Per-framework counts in sorted framework order
+	Frameworks []FrameworkSummary `json:"frameworks"`
+
+	// Unmapped Number of evidence results that did not map to any framework
+	Unmapped int `json:"unmapped"`
+}
+
+// GetV1ControlsIdRulesParams defines parameters for GetV1ControlsIdRules.
+type GetV1ControlsIdRulesParams struct {
+	// CatalogId Only list rules for the control in this catalog
+	CatalogId *string `form:"catalogId,omitempty" json:"catalogId,omitempty"`
+}
+
+// GetV1CoverageParams defines parameters for GetV1Coverage.
+type GetV1CoverageParams struct {
+	// Catalog Unique identifier for the control catalog
+	Catalog string `form:"catalog" json:"catalog"`
+}
+
 // PostV1EnrichJSONRequestBody defines body for PostV1Enrich for application/json ContentType.
 type PostV1EnrichJSONRequestBody = EnrichmentRequest
 
+// PostV1SummaryJSONRequestBody defines body for PostV1Summary for application/json ContentType.
+type PostV1SummaryJSONRequestBody = SummaryRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -230,14 +457,40 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// PostV1AdminReload request
+	PostV1AdminReload(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1ControlsIdRules request
+	GetV1ControlsIdRules(ctx context.Context, id string, params *GetV1ControlsIdRulesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1Coverage request
+	GetV1Coverage(ctx context.Context, params *GetV1CoverageParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1DebugPlans request
+	GetV1DebugPlans(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1Engines request
+	GetV1Engines(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostV1EnrichWithBody request with any body
 	PostV1EnrichWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostV1Enrich(ctx context.Context, body PostV1EnrichJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1Rules request
+	GetV1Rules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1Schema request
+	GetV1Schema(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1SummaryWithBody request with any body
+	PostV1SummaryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV1Summary(ctx context.Context, body PostV1SummaryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) PostV1EnrichWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostV1EnrichRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostV1AdminReload(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1AdminReloadRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -248,8 +501,8 @@ func (c *Client) PostV1EnrichWithBody(ctx context.Context, contentType string, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostV1Enrich(ctx context.Context, body PostV1EnrichJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostV1EnrichRequest(c.Server, body)
+func (c *Client) GetV1ControlsIdRules(ctx context.Context, id string, params *GetV1ControlsIdRulesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1ControlsIdRulesRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -260,151 +513,1089 @@ func (c *Client) PostV1Enrich(ctx context.Context, body PostV1EnrichJSONRequestB
 	return c.Client.Do(req)
 }
 
-// NewPostV1EnrichRequest calls the generic PostV1Enrich builder with application/json body
-func NewPostV1EnrichRequest(server string, body PostV1EnrichJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetV1Coverage(ctx context.Context, params *GetV1CoverageParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1CoverageRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostV1EnrichRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostV1EnrichRequestWithBody generates requests for PostV1Enrich with any type of body
-func NewPostV1EnrichRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetV1DebugPlans(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1DebugPlansRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/enrich")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetV1Engines(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1EnginesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) PostV1EnrichWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1EnrichRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) PostV1Enrich(ctx context.Context, body PostV1EnrichJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1EnrichRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+func (c *Client) GetV1Rules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1RulesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *Client) GetV1Schema(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1SchemaRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+func (c *Client) PostV1SummaryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1SummaryRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PostV1EnrichWithBodyWithResponse request with any body
-	PostV1EnrichWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1EnrichResponse, error)
-
-	PostV1EnrichWithResponse(ctx context.Context, body PostV1EnrichJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1EnrichResponse, error)
+func (c *Client) PostV1Summary(ctx context.Context, body PostV1SummaryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1SummaryRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type PostV1EnrichResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *EnrichmentResponse
-	JSONDefault  *Error
-}
+// NewPostV1AdminReloadRequest generates requests for PostV1AdminReload
+func NewPostV1AdminReloadRequest(server string) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r PostV1EnrichResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostV1EnrichResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/v1/admin/reload")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-// PostV1EnrichWithBodyWithResponse request with arbitrary body returning *PostV1EnrichResponse
-func (c *ClientWithResponses) PostV1EnrichWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1EnrichResponse, error) {
-	rsp, err := c.PostV1EnrichWithBody(ctx, contentType, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostV1EnrichResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostV1EnrichWithResponse(ctx context.Context, body PostV1EnrichJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1EnrichResponse, error) {
-	rsp, err := c.PostV1Enrich(ctx, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostV1EnrichResponse(rsp)
+
+	return req, nil
 }
 
-// ParsePostV1EnrichResponse parses an HTTP response from a PostV1EnrichWithResponse call
-func ParsePostV1EnrichResponse(rsp *http.Response) (*PostV1EnrichResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetV1ControlsIdRulesRequest generates requests for GetV1ControlsIdRules
+func NewGetV1ControlsIdRulesRequest(server string, id string, params *GetV1ControlsIdRulesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostV1EnrichResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EnrichmentResponse
+	operationPath := fmt.Sprintf("/v1/controls/%s/rules", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.CatalogId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "catalogId", runtime.ParamLocationQuery, *params.CatalogId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1CoverageRequest generates requests for GetV1Coverage
+func NewGetV1CoverageRequest(server string, params *GetV1CoverageParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/coverage")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "catalog", runtime.ParamLocationQuery, params.Catalog); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1DebugPlansRequest generates requests for GetV1DebugPlans
+func NewGetV1DebugPlansRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/debug/plans")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1EnginesRequest generates requests for GetV1Engines
+func NewGetV1EnginesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/engines")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1EnrichRequest calls the generic PostV1Enrich builder with application/json body
+func NewPostV1EnrichRequest(server string, body PostV1EnrichJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV1EnrichRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostV1EnrichRequestWithBody generates requests for PostV1Enrich with any type of body
+func NewPostV1EnrichRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/enrich")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV1RulesRequest generates requests for GetV1Rules
+func NewGetV1RulesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/rules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1SchemaRequest generates requests for GetV1Schema
+func NewGetV1SchemaRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/schema")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1SummaryRequest calls the generic PostV1Summary builder with application/json body
+func NewPostV1SummaryRequest(server string, body PostV1SummaryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV1SummaryRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostV1SummaryRequestWithBody generates requests for PostV1Summary with any type of body
+func NewPostV1SummaryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/summary")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// PostV1AdminReloadWithResponse request
+	PostV1AdminReloadWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostV1AdminReloadResponse, error)
+
+	// GetV1ControlsIdRulesWithResponse request
+	GetV1ControlsIdRulesWithResponse(ctx context.Context, id string, params *GetV1ControlsIdRulesParams, reqEditors ...RequestEditorFn) (*GetV1ControlsIdRulesResponse, error)
+
+	// GetV1CoverageWithResponse request
+	GetV1CoverageWithResponse(ctx context.Context, params *GetV1CoverageParams, reqEditors ...RequestEditorFn) (*GetV1CoverageResponse, error)
+
+	// GetV1DebugPlansWithResponse request
+	GetV1DebugPlansWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1DebugPlansResponse, error)
+
+	// GetV1EnginesWithResponse request
+	GetV1EnginesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1EnginesResponse, error)
+
+	// PostV1EnrichWithBodyWithResponse request with any body
+	PostV1EnrichWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1EnrichResponse, error)
+
+	PostV1EnrichWithResponse(ctx context.Context, body PostV1EnrichJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1EnrichResponse, error)
+
+	// GetV1RulesWithResponse request
+	GetV1RulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1RulesResponse, error)
+
+	// GetV1SchemaWithResponse request
+	GetV1SchemaWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1SchemaResponse, error)
+
+	// PostV1SummaryWithBodyWithResponse request with any body
+	PostV1SummaryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1SummaryResponse, error)
+
+	PostV1SummaryWithResponse(ctx context.Context, body PostV1SummaryJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1SummaryResponse, error)
+}
+
+type PostV1AdminReloadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReloadResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1AdminReloadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1AdminReloadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1ControlsIdRulesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ControlRulesResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1ControlsIdRulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1ControlsIdRulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1CoverageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CoverageResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1CoverageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1CoverageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1DebugPlansResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PlansResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1DebugPlansResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1DebugPlansResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1EnginesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EnginesResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1EnginesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1EnginesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1EnrichResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EnrichmentResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1EnrichResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1EnrichResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1RulesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RulesResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1RulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1RulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetV1SchemaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SchemaResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1SchemaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1SchemaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostV1SummaryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SummaryResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1SummaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1SummaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// PostV1AdminReloadWithResponse request returning *PostV1AdminReloadResponse
+func (c *ClientWithResponses) PostV1AdminReloadWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostV1AdminReloadResponse, error) {
+	rsp, err := c.PostV1AdminReload(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1AdminReloadResponse(rsp)
+}
+
+// GetV1ControlsIdRulesWithResponse request returning *GetV1ControlsIdRulesResponse
+func (c *ClientWithResponses) GetV1ControlsIdRulesWithResponse(ctx context.Context, id string, params *GetV1ControlsIdRulesParams, reqEditors ...RequestEditorFn) (*GetV1ControlsIdRulesResponse, error) {
+	rsp, err := c.GetV1ControlsIdRules(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1ControlsIdRulesResponse(rsp)
+}
+
+// GetV1CoverageWithResponse request returning *GetV1CoverageResponse
+func (c *ClientWithResponses) GetV1CoverageWithResponse(ctx context.Context, params *GetV1CoverageParams, reqEditors ...RequestEditorFn) (*GetV1CoverageResponse, error) {
+	rsp, err := c.GetV1Coverage(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1CoverageResponse(rsp)
+}
+
+// GetV1DebugPlansWithResponse request returning *GetV1DebugPlansResponse
+func (c *ClientWithResponses) GetV1DebugPlansWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1DebugPlansResponse, error) {
+	rsp, err := c.GetV1DebugPlans(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1DebugPlansResponse(rsp)
+}
+
+// GetV1EnginesWithResponse request returning *GetV1EnginesResponse
+func (c *ClientWithResponses) GetV1EnginesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1EnginesResponse, error) {
+	rsp, err := c.GetV1Engines(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1EnginesResponse(rsp)
+}
+
+// PostV1EnrichWithBodyWithResponse request with arbitrary body returning *PostV1EnrichResponse
+func (c *ClientWithResponses) PostV1EnrichWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1EnrichResponse, error) {
+	rsp, err := c.PostV1EnrichWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1EnrichResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV1EnrichWithResponse(ctx context.Context, body PostV1EnrichJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1EnrichResponse, error) {
+	rsp, err := c.PostV1Enrich(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1EnrichResponse(rsp)
+}
+
+// GetV1RulesWithResponse request returning *GetV1RulesResponse
+func (c *ClientWithResponses) GetV1RulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1RulesResponse, error) {
+	rsp, err := c.GetV1Rules(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1RulesResponse(rsp)
+}
+
+// GetV1SchemaWithResponse request returning *GetV1SchemaResponse
+func (c *ClientWithResponses) GetV1SchemaWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1SchemaResponse, error) {
+	rsp, err := c.GetV1Schema(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1SchemaResponse(rsp)
+}
+
+// PostV1SummaryWithBodyWithResponse request with arbitrary body returning *PostV1SummaryResponse
+func (c *ClientWithResponses) PostV1SummaryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1SummaryResponse, error) {
+	rsp, err := c.PostV1SummaryWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1SummaryResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV1SummaryWithResponse(ctx context.Context, body PostV1SummaryJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1SummaryResponse, error) {
+	rsp, err := c.PostV1Summary(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1SummaryResponse(rsp)
+}
+
+// ParsePostV1AdminReloadResponse parses an HTTP response from a PostV1AdminReloadWithResponse call
+func ParsePostV1AdminReloadResponse(rsp *http.Response) (*PostV1AdminReloadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1AdminReloadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReloadResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1ControlsIdRulesResponse parses an HTTP response from a GetV1ControlsIdRulesWithResponse call
+func ParseGetV1ControlsIdRulesResponse(rsp *http.Response) (*GetV1ControlsIdRulesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1ControlsIdRulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ControlRulesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1CoverageResponse parses an HTTP response from a GetV1CoverageWithResponse call
+func ParseGetV1CoverageResponse(rsp *http.Response) (*GetV1CoverageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1CoverageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CoverageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1DebugPlansResponse parses an HTTP response from a GetV1DebugPlansWithResponse call
+func ParseGetV1DebugPlansResponse(rsp *http.Response) (*GetV1DebugPlansResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1DebugPlansResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PlansResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1EnginesResponse parses an HTTP response from a GetV1EnginesWithResponse call
+func ParseGetV1EnginesResponse(rsp *http.Response) (*GetV1EnginesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1EnginesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EnginesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1EnrichResponse parses an HTTP response from a PostV1EnrichWithResponse call
+func ParsePostV1EnrichResponse(rsp *http.Response) (*PostV1EnrichResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1EnrichResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EnrichmentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1RulesResponse parses an HTTP response from a GetV1RulesWithResponse call
+func ParseGetV1RulesResponse(rsp *http.Response) (*GetV1RulesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1RulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RulesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1SchemaResponse parses an HTTP response from a GetV1SchemaWithResponse call
+func ParseGetV1SchemaResponse(rsp *http.Response) (*GetV1SchemaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1SchemaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1SummaryResponse parses an HTTP response from a PostV1SummaryWithResponse call
+func ParsePostV1SummaryResponse(rsp *http.Response) (*PostV1SummaryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1SummaryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SummaryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
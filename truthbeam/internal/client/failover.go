@@ -0,0 +1,96 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// NewFailoverClient creates a Client that sends each request to the first
+// endpoint and, on a connection failure or 5xx response, tries the remaining
+// endpoints in order. The first endpoint is always preferred, so traffic
+// returns to it as soon as it is healthy again.
+func NewFailoverClient(endpoints []string, opts ...ClientOption) (*Client, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("at least one endpoint must be specified")
+	}
+	c, err := NewClient(endpoints[0], opts...)
+	if err != nil {
+		return nil, err
+	}
+	if len(endpoints) == 1 {
+		return c, nil
+	}
+
+	servers := make([]*url.URL, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		server, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.HasSuffix(server.Path, "/") {
+			server.Path += "/"
+		}
+		servers = append(servers, server)
+	}
+	c.Client = &failoverDoer{doer: c.Client, servers: servers}
+	return c, nil
+}
+
+// failoverDoer retries requests built against the first server on each of
+// the remaining servers.
+type failoverDoer struct {
+	doer    HttpRequestDoer
+	servers []*url.URL
+}
+
+func (f *failoverDoer) Do(req *http.Request) (*http.Response, error) {
+	// The operation path and query, relative to the base path of the first
+	// server, are resolved against each server in turn.
+	operation := &url.URL{
+		Path:     strings.TrimPrefix(req.URL.Path, f.servers[0].Path),
+		RawQuery: req.URL.RawQuery,
+	}
+
+	var lastErr error
+	for i, server := range f.servers {
+		if err := req.Context().Err(); err != nil {
+			return nil, err
+		}
+		attempt, err := f.request(req, server.ResolveReference(operation))
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := f.doer.Do(attempt)
+		last := i == len(f.servers)-1
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if resp.StatusCode >= http.StatusInternalServerError && !last {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+			continue
+		}
+		return resp, nil
+	}
+	return nil, lastErr
+}
+
+// request clones req for the target URL with a fresh copy of the body.
+func (f *failoverDoer) request(req *http.Request, target *url.URL) (*http.Request, error) {
+	attempt := req.Clone(req.Context())
+	attempt.URL = target
+	attempt.Host = ""
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		attempt.Body = body
+	}
+	return attempt, nil
+}
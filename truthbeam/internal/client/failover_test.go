@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFailoverClient(t *testing.T) {
+	newServer := func(status int, hits *atomic.Int32) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			hits.Add(1)
+			var req EnrichmentRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, "test-policy-123", req.Evidence.PolicyRuleId)
+
+			w.Header().Set("Content-Type", "application/json")
+			if status != http.StatusOK {
+				w.WriteHeader(status)
+				_ = json.NewEncoder(w).Encode(Error{Code: int32(status), Message: "unavailable"})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(EnrichmentResponse{
+				Compliance: Compliance{
+					Control:          ComplianceControl{Id: "AC-1"},
+					Status:           ComplianceStatusCompliant,
+					EnrichmentStatus: ComplianceEnrichmentStatusSuccess,
+				},
+			})
+		}))
+	}
+
+	down := httptest.NewServer(http.NotFoundHandler())
+	downURL := down.URL
+	down.Close()
+
+	tests := []struct {
+		name              string
+		primaryStatus     int
+		primaryDown       bool
+		expectedPrimary   int32
+		expectedSecondary int32
+	}{
+		{
+			name:              "primary healthy",
+			primaryStatus:     http.StatusOK,
+			expectedPrimary:   1,
+			expectedSecondary: 0,
+		},
+		{
+			name:              "primary down",
+			primaryDown:       true,
+			expectedPrimary:   0,
+			expectedSecondary: 1,
+		},
+		{
+			name:              "primary returns 5xx",
+			primaryStatus:     http.StatusServiceUnavailable,
+			expectedPrimary:   1,
+			expectedSecondary: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var primaryHits, secondaryHits atomic.Int32
+			secondary := newServer(http.StatusOK, &secondaryHits)
+			defer secondary.Close()
+
+			primaryURL := downURL
+			if !tt.primaryDown {
+				primary := newServer(tt.primaryStatus, &primaryHits)
+				defer primary.Close()
+				primaryURL = primary.URL
+			}
+
+			client, err := NewFailoverClient([]string{primaryURL, secondary.URL})
+			require.NoError(t, err)
+
+			logRecord, resource := createTestLogRecord()
+			err = client.ApplyAttributes(context.Background(), resource, logRecord)
+			require.NoError(t, err)
+
+			controlID, ok := logRecord.Attributes().Get(COMPLIANCE_CONTROL_ID)
+			require.True(t, ok)
+			assert.Equal(t, "AC-1", controlID.Str())
+			assert.Equal(t, tt.expectedPrimary, primaryHits.Load())
+			assert.Equal(t, tt.expectedSecondary, secondaryHits.Load())
+		})
+	}
+}
+
+func TestFailoverDoerResolvesEachServer(t *testing.T) {
+	var paths []string
+	doer := &recordingDoer{do: func(req *http.Request) (*http.Response, error) {
+		paths = append(paths, req.URL.String())
+		return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: http.NoBody}, nil
+	}}
+
+	client, err := NewFailoverClient(
+		[]string{"http://primary:8081/compass", "http://secondary:8081/", "http://tertiary:8081"},
+		WithHTTPClient(doer),
+	)
+	require.NoError(t, err)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://primary:8081/compass/v1/rules?engine=opa", nil)
+	require.NoError(t, err)
+	resp, err := client.Client.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	assert.Equal(t, []string{
+		"http://primary:8081/compass/v1/rules?engine=opa",
+		"http://secondary:8081/v1/rules?engine=opa",
+		"http://tertiary:8081/v1/rules?engine=opa",
+	}, paths)
+}
+
+// recordingDoer answers requests with do.
+type recordingDoer struct {
+	do func(req *http.Request) (*http.Response, error)
+}
+
+func (d *recordingDoer) Do(req *http.Request) (*http.Response, error) {
+	return d.do(req)
+}
+
+func TestNewFailoverClientAllEndpointsDown(t *testing.T) {
+	down := httptest.NewServer(http.NotFoundHandler())
+	downURL := down.URL
+	down.Close()
+
+	client, err := NewFailoverClient([]string{downURL, downURL})
+	require.NoError(t, err)
+
+	logRecord, resource := createTestLogRecord()
+	err = client.ApplyAttributes(context.Background(), resource, logRecord)
+	assert.Error(t, err)
+}
+
+func TestNewFailoverClientNoEndpoints(t *testing.T) {
+	_, err := NewFailoverClient(nil)
+	assert.ErrorContains(t, err, "at least one endpoint")
+}
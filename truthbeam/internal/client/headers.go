@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+)
+
+// TokenSource returns the bearer token to send to compass. It is called for
+// every request, so a rotated token is picked up without recreating the client.
+type TokenSource func(ctx context.Context) (string, error)
+
+// WithHeaders sets static headers, such as an API key required by an auth
+// gateway, on every request the client sends.
+func WithHeaders(headers map[string]string) ClientOption {
+	headers = maps.Clone(headers)
+	return WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		return nil
+	})
+}
+
+// WithTokenSource sets the Authorization header of every request the client
+// sends to a bearer token from source. A request fails without being sent if
+// source returns an error.
+func WithTokenSource(source TokenSource) ClientOption {
+	return WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		token, err := source(ctx)
+		if err != nil {
+			return fmt.Errorf("getting compass token: %w", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	})
+}
@@ -0,0 +1,166 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// headerRecorder is a compass stub that records the headers of every request
+// by path.
+type headerRecorder struct {
+	mu      sync.Mutex
+	headers map[string]http.Header
+}
+
+func newHeaderServer(t *testing.T) (*httptest.Server, *headerRecorder) {
+	recorder := &headerRecorder{headers: make(map[string]http.Header)}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorder.mu.Lock()
+		recorder.headers[r.URL.Path] = r.Header.Clone()
+		recorder.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/v1/enrich" {
+			_, _ = fmt.Fprint(w, `{"compliance": {"enrichmentStatus": "Unmapped"}}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{}`)
+	}))
+	t.Cleanup(server.Close)
+	return server, recorder
+}
+
+func (r *headerRecorder) header(path, name string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.headers[path].Get(name)
+}
+
+func TestClientHeaders(t *testing.T) {
+	calls := []struct {
+		name string
+		path string
+		call func(ctx context.Context, c *Client) error
+	}{
+		{
+			name: "enrich",
+			path: "/v1/enrich",
+			call: func(ctx context.Context, c *Client) error {
+				logRecord, resource := createTestLogRecord()
+				return c.ApplyAttributes(ctx, resource, logRecord)
+			},
+		},
+		{
+			name: "metadata",
+			path: "/v1/engines",
+			call: func(ctx context.Context, c *Client) error {
+				resp, err := c.GetV1Engines(ctx)
+				if err != nil {
+					return err
+				}
+				return resp.Body.Close()
+			},
+		},
+		{
+			name: "batch",
+			path: "/v1/summary",
+			call: func(ctx context.Context, c *Client) error {
+				resp, err := c.PostV1Summary(ctx, SummaryRequest{
+					Evidence: []Evidence{{
+						Timestamp:              time.Now(),
+						PolicyEngineName:       "opa",
+						PolicyRuleId:           "deny-root-user",
+						PolicyEvaluationStatus: EvidencePolicyEvaluationStatusFailed,
+					}},
+				})
+				if err != nil {
+					return err
+				}
+				return resp.Body.Close()
+			},
+		},
+		{
+			name: "ping",
+			path: "/",
+			call: Ping,
+		},
+	}
+
+	for _, tt := range calls {
+		t.Run(tt.name, func(t *testing.T) {
+			server, recorder := newHeaderServer(t)
+			c, err := NewClient(server.URL,
+				WithHeaders(map[string]string{"X-API-Key": "secret"}),
+				WithTokenSource(func(context.Context) (string, error) { return "token", nil }),
+			)
+			require.NoError(t, err)
+
+			require.NoError(t, tt.call(context.Background(), c))
+			assert.Equal(t, "secret", recorder.header(tt.path, "X-API-Key"))
+			assert.Equal(t, "Bearer token", recorder.header(tt.path, "Authorization"))
+		})
+	}
+}
+
+func TestClientHeadersFailover(t *testing.T) {
+	server, recorder := newHeaderServer(t)
+	c, err := NewFailoverClient([]string{"http://127.0.0.1:1", server.URL},
+		WithHeaders(map[string]string{"X-API-Key": "secret"}),
+	)
+	require.NoError(t, err)
+
+	logRecord, resource := createTestLogRecord()
+	require.NoError(t, c.ApplyAttributes(context.Background(), resource, logRecord))
+	assert.Equal(t, "secret", recorder.header("/v1/enrich", "X-API-Key"))
+}
+
+func TestWithHeadersCopiesHeaders(t *testing.T) {
+	server, recorder := newHeaderServer(t)
+	headers := map[string]string{"X-API-Key": "secret"}
+	c, err := NewClient(server.URL, WithHeaders(headers))
+	require.NoError(t, err)
+	headers["X-API-Key"] = "changed"
+
+	require.NoError(t, Ping(context.Background(), c))
+	assert.Equal(t, "secret", recorder.header("/", "X-API-Key"))
+}
+
+func TestWithTokenSource(t *testing.T) {
+	t.Run("token is fetched for every request", func(t *testing.T) {
+		server, recorder := newHeaderServer(t)
+		var calls int
+		c, err := NewClient(server.URL, WithTokenSource(func(context.Context) (string, error) {
+			calls++
+			return fmt.Sprintf("token-%d", calls), nil
+		}))
+		require.NoError(t, err)
+
+		require.NoError(t, Ping(context.Background(), c))
+		assert.Equal(t, "Bearer token-1", recorder.header("/", "Authorization"))
+		require.NoError(t, Ping(context.Background(), c))
+		assert.Equal(t, "Bearer token-2", recorder.header("/", "Authorization"))
+	})
+
+	t.Run("token errors fail the request", func(t *testing.T) {
+		server, recorder := newHeaderServer(t)
+		tokenErr := errors.New("token expired")
+		c, err := NewClient(server.URL, WithTokenSource(func(context.Context) (string, error) {
+			return "", tokenErr
+		}))
+		require.NoError(t, err)
+
+		logRecord, resource := createTestLogRecord()
+		err = c.ApplyAttributes(context.Background(), resource, logRecord)
+		assert.ErrorIs(t, err, tokenErr)
+		assert.Empty(t, recorder.header("/v1/enrich", "Authorization"), "request should not be sent")
+	})
+}
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// Ping checks that the compass server is reachable with the client's HTTP
+// configuration. Any HTTP response, regardless of status code, means the
+// server could be reached; only transport failures are returned. Headers set
+// with client options are sent as with any other request.
+func Ping(ctx context.Context, client *Client) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, client.Server, nil)
+	if err != nil {
+		return err
+	}
+	if err := client.applyEditors(ctx, req, nil); err != nil {
+		return err
+	}
+	resp, err := client.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
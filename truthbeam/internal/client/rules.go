@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// RuleIndex records the policy rules compass can map, as listed by
+// GET /v1/rules.
+type RuleIndex struct {
+	engines  map[string]map[string]struct{}
+	fallback map[string]struct{}
+	complete bool
+	// unmappedMapperIds and unmappedMapperId hold the mapper ID compass
+	// reports for unmapped evidence from registered and other engines.
+	unmappedMapperIds map[string]string
+	unmappedMapperId  string
+}
+
+// FetchRules lists the policy rules compass can map.
+func FetchRules(ctx context.Context, client *Client) (*RuleIndex, error) {
+	resp, err := client.GetV1Rules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("listing compass rules failed with status %d", resp.StatusCode)
+	}
+	var rules RulesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&rules); err != nil {
+		return nil, err
+	}
+	return NewRuleIndex(rules), nil
+}
+
+// NewRuleIndex returns an index of the rules in a GET /v1/rules response.
+func NewRuleIndex(rules RulesResponse) *RuleIndex {
+	index := &RuleIndex{
+		engines:  make(map[string]map[string]struct{}, len(rules.Engines)),
+		fallback: ruleSet(rules.FallbackRules),
+		complete: rules.Complete,
+	}
+	if rules.UnmappedMapperIds != nil {
+		index.unmappedMapperIds = *rules.UnmappedMapperIds
+	}
+	if rules.UnmappedMapperId != nil {
+		index.unmappedMapperId = *rules.UnmappedMapperId
+	}
+	for engine, engineRules := range rules.Engines {
+		index.engines[engine] = ruleSet(engineRules)
+	}
+	return index
+}
+
+func ruleSet(rules []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(rules))
+	for _, rule := range rules {
+		set[rule] = struct{}{}
+	}
+	return set
+}
+
+// Complete reports whether compass listed every rule it can map. An
+// incomplete index cannot tell that a rule is unmapped.
+func (r *RuleIndex) Complete() bool {
+	return r.complete
+}
+
+// Maps reports whether compass lists rule as mapped for evidence from engine,
+// either by the engine's own mapper or by a fallback mapper.
+func (r *RuleIndex) Maps(engine, rule string) bool {
+	if _, ok := r.engines[engine][rule]; ok {
+		return true
+	}
+	_, ok := r.fallback[rule]
+	return ok
+}
+
+// UnmappedMapperID returns the mapper ID compass reports for evidence from
+// engine that it cannot map, or an empty string when compass did not list it.
+func (r *RuleIndex) UnmappedMapperID(engine string) string {
+	if _, ok := r.engines[engine]; ok {
+		return r.unmappedMapperIds[engine]
+	}
+	return r.unmappedMapperId
+}
+
+// Len returns the number of rules in the index, counting the rules of each
+// engine and the fallback rules separately.
+func (r *RuleIndex) Len() int {
+	n := len(r.fallback)
+	for _, rules := range r.engines {
+		n += len(rules)
+	}
+	return n
+}
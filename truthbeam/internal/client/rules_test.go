@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchRules(t *testing.T) {
+	t.Run("rules are indexed by engine", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/v1/rules", r.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(RulesResponse{
+				Engines: map[string][]string{
+					"opa":      {"deny-root-user"},
+					"conforma": {},
+				},
+				FallbackRules: []string{"github_branch_protection"},
+				Complete:      true,
+			})
+		}))
+		defer server.Close()
+
+		c, err := NewClient(server.URL)
+		require.NoError(t, err)
+
+		rules, err := FetchRules(context.Background(), c)
+		require.NoError(t, err)
+		assert.True(t, rules.Complete())
+		assert.Equal(t, 2, rules.Len())
+	})
+
+	t.Run("error status is returned", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		c, err := NewClient(server.URL)
+		require.NoError(t, err)
+
+		_, err = FetchRules(context.Background(), c)
+		assert.ErrorContains(t, err, "status 404")
+	})
+}
+
+func TestRuleIndexMaps(t *testing.T) {
+	rules := NewRuleIndex(RulesResponse{
+		Engines: map[string][]string{
+			"opa":      {"deny-root-user"},
+			"conforma": {"require-signed-images"},
+		},
+		FallbackRules: []string{"github_branch_protection"},
+		Complete:      true,
+	})
+
+	tests := []struct {
+		name     string
+		engine   string
+		rule     string
+		expected bool
+	}{
+		{name: "rule of the engine's mapper", engine: "opa", rule: "deny-root-user", expected: true},
+		{name: "rule of another engine's mapper", engine: "opa", rule: "require-signed-images", expected: false},
+		{name: "fallback rule for a registered engine", engine: "opa", rule: "github_branch_protection", expected: true},
+		{name: "fallback rule for an unregistered engine", engine: "kyverno", rule: "github_branch_protection", expected: true},
+		{name: "unknown rule", engine: "opa", rule: "unknown", expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, rules.Maps(tt.engine, tt.rule))
+		})
+	}
+}
+
+func TestRuleIndexUnmappedMapperID(t *testing.T) {
+	unmappedMapperId := "basic"
+	rules := NewRuleIndex(RulesResponse{
+		Engines: map[string][]string{
+			"opa":      {"deny-root-user"},
+			"conforma": {},
+		},
+		FallbackRules:     []string{},
+		Complete:          true,
+		UnmappedMapperIds: &map[string]string{"opa": "opa-mapper"},
+		UnmappedMapperId:  &unmappedMapperId,
+	})
+
+	assert.Equal(t, "opa-mapper", rules.UnmappedMapperID("opa"))
+	assert.Equal(t, "", rules.UnmappedMapperID("conforma"))
+	assert.Equal(t, "basic", rules.UnmappedMapperID("kyverno"))
+	assert.Equal(t, "", NewRuleIndex(RulesResponse{}).UnmappedMapperID("kyverno"))
+}
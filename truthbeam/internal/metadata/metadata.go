@@ -5,5 +5,6 @@ import "go.opentelemetry.io/collector/component"
 var Type = component.MustNewType("truthbeam")
 
 const (
-	LogsStability = component.StabilityLevelAlpha
+	LogsStability   = component.StabilityLevelAlpha
+	TracesStability = component.StabilityLevelDevelopment
 )
@@ -3,9 +3,15 @@ package truthbeam
 import (
 	"context"
 	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor"
 	"go.uber.org/zap"
 
@@ -18,9 +24,35 @@ type truthBeamProcessor struct {
 
 	logger *zap.Logger
 
-	client *client.Client
+	client     client.EnrichmentClient
+	httpClient *http.Client
+	// keys are the compliance attribute keys the client writes.
+	keys client.KeyMapping
+	// requests holds a slot for each enrichment call in flight when
+	// MaxConcurrentRequests is set.
+	requests chan struct{}
 
-	// TODO: Cache results by policy id
+	// TODO: Cache results by policy engine and rule id. Rule ids are only
+	// unique per engine, so keying on the rule id alone would collide.
+
+	// rules holds the policy rules compass can map when PrefetchRules is
+	// enabled and compass listed them all; nil means every record is looked up.
+	rules     atomic.Pointer[client.RuleIndex]
+	stopRules chan struct{}
+	rulesWG   sync.WaitGroup
+
+	stats     enrichmentStats
+	stopStats chan struct{}
+	statsWG   sync.WaitGroup
+}
+
+// enrichmentStats counts enrichment outcomes since the last stats report.
+type enrichmentStats struct {
+	enriched atomic.Int64
+	failed   atomic.Int64
+	// unmapped counts records the prefetched rules marked unmapped without
+	// a lookup.
+	unmapped atomic.Int64
 }
 
 func newTruthBeamProcessor(conf component.Config, set processor.Settings) (*truthBeamProcessor, error) {
@@ -29,15 +61,26 @@ func newTruthBeamProcessor(conf component.Config, set processor.Settings) (*trut
 		return nil, errors.New("invalid configuration provided")
 	}
 
+	var requests chan struct{}
+	if cfg.MaxConcurrentRequests > 0 {
+		requests = make(chan struct{}, cfg.MaxConcurrentRequests)
+	}
+
 	return &truthBeamProcessor{
 		config:    cfg,
 		telemetry: set.TelemetrySettings,
 		logger:    set.Logger,
 		client:    nil,
+		requests:  requests,
+		keys: client.KeyMapping{
+			Prefix:    cfg.AttributeKeyPrefix,
+			Overrides: cfg.AttributeKeyOverrides,
+		},
 	}, nil
 }
 
 func (t *truthBeamProcessor) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	var errs []error
 	rl := ld.ResourceLogs()
 	for i := 0; i < rl.Len(); i++ {
 		rs := rl.At(i)
@@ -48,28 +91,329 @@ func (t *truthBeamProcessor) processLogs(ctx context.Context, ld plog.Logs) (plo
 			resource := rs.Resource()
 			for k := 0; k < logs.Len(); k++ {
 				logRecord := logs.At(k)
-				err := client.ApplyAttributes(ctx, t.client, t.config.ClientConfig.Endpoint, resource, logRecord)
+				if !t.config.ForceReenrich && t.isEnriched(logRecord.Attributes()) {
+					continue
+				}
+				err := t.enrich(ctx, logRecord.Attributes(), func(ctx context.Context) error {
+					return t.client.ApplyAttributes(ctx, resource, logRecord)
+				}, func(mapperID string) error {
+					return t.client.ApplyUnmappedAttributes(logRecord, mapperID)
+				})
 				if err != nil {
-					// We don't want to return an error here to ensure the evidence
-					// is not dropped. It will just be uncategorized.
-					t.logger.Error("failed to apply attributes", zap.Error(err))
+					errs = append(errs, err)
 				}
 			}
 		}
 	}
+	if t.config.FailOnError {
+		return ld, errors.Join(errs...)
+	}
 	return ld, nil
 }
 
+// processTraces enriches spans that record a policy evaluation. Unlike log
+// records, spans without a policy rule id are ordinary application spans and
+// are passed through untouched.
+func (t *truthBeamProcessor) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	var errs []error
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		resource := rs.Resource()
+		ilss := rs.ScopeSpans()
+		for j := 0; j < ilss.Len(); j++ {
+			spans := ilss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				attrs := span.Attributes()
+				if _, ok := attrs.Get(client.POLICY_RULE_ID); !ok {
+					continue
+				}
+				if !t.config.ForceReenrich && t.isEnriched(attrs) {
+					continue
+				}
+				err := t.enrich(ctx, attrs, func(ctx context.Context) error {
+					return t.client.ApplySpanAttributes(ctx, resource, span)
+				}, func(mapperID string) error {
+					return t.client.ApplyUnmappedSpanAttributes(span, mapperID)
+				})
+				if err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
+	}
+	if t.config.FailOnError {
+		return td, errors.Join(errs...)
+	}
+	return td, nil
+}
+
+// enrich enriches a single log record or span through apply and records the
+// outcome. attrs are the attributes apply writes to. Records the prefetched
+// rules show compass cannot map are marked through unmapped instead, with the
+// mapper ID compass reports for them.
+func (t *truthBeamProcessor) enrich(ctx context.Context, attrs pcommon.Map, apply func(context.Context) error, unmapped func(mapperID string) error) error {
+	var err error
+	mapperID, known := t.knownUnmapped(attrs)
+	if known {
+		err = unmapped(mapperID)
+	} else {
+		err = t.applyAttributes(ctx, apply)
+	}
+	if err != nil {
+		// Unless FailOnError is set, the error is not returned to ensure
+		// the evidence is not dropped. It will just be uncategorized.
+		t.logger.Error("failed to apply attributes", zap.Error(err))
+		t.stats.failed.Add(1)
+		return err
+	}
+	if known {
+		t.stats.unmapped.Add(1)
+	} else {
+		t.stats.enriched.Add(1)
+	}
+	if t.config.AuditLog {
+		t.auditEnrichment(attrs)
+	}
+	return nil
+}
+
+// knownUnmapped reports whether the prefetched rules show that compass cannot
+// map the evidence in attrs, and the mapper ID compass reports for it.
+// Records missing the lookup attributes are left to the client, which
+// reports them as skipped.
+func (t *truthBeamProcessor) knownUnmapped(attrs pcommon.Map) (string, bool) {
+	rules := t.rules.Load()
+	if rules == nil {
+		return "", false
+	}
+	engine, rule := attrString(attrs, client.POLICY_ENGINE_NAME), attrString(attrs, client.POLICY_RULE_ID)
+	if engine == "" || rule == "" || rules.Maps(engine, rule) {
+		return "", false
+	}
+	return rules.UnmappedMapperID(engine), true
+}
+
+// applyAttributes runs apply, bounded by the configured per-call enrichment
+// timeout. When the concurrency limit is reached, it waits for a free slot
+// first; the wait does not count towards the timeout.
+func (t *truthBeamProcessor) applyAttributes(ctx context.Context, apply func(context.Context) error) error {
+	if t.requests != nil {
+		select {
+		case t.requests <- struct{}{}:
+			defer func() { <-t.requests }()
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if t.config.EnrichmentTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, t.config.EnrichmentTimeout)
+		defer cancel()
+	}
+	return apply(ctx)
+}
+
+// auditEnrichment logs the enrichment decision recorded in the attributes of
+// a log record or span.
+func (t *truthBeamProcessor) auditEnrichment(attrs pcommon.Map) {
+	t.logger.Info("compliance enrichment applied",
+		zap.String(client.POLICY_RULE_ID, attrString(attrs, client.POLICY_RULE_ID)),
+		zap.String(client.POLICY_ENGINE_NAME, attrString(attrs, client.POLICY_ENGINE_NAME)),
+		zap.String(client.POLICY_EVALUATION_RESULT, attrString(attrs, client.POLICY_EVALUATION_RESULT)),
+		zap.String(client.COMPLIANCE_CONTROL_ID, t.complianceString(attrs, client.COMPLIANCE_CONTROL_ID)),
+		zap.String(client.COMPLIANCE_CONTROL_CATALOG_ID, t.complianceString(attrs, client.COMPLIANCE_CONTROL_CATALOG_ID)),
+		zap.Strings(client.COMPLIANCE_FRAMEWORKS, attrStrings(attrs, t.keys.Key(client.COMPLIANCE_FRAMEWORKS))),
+		zap.Strings(client.COMPLIANCE_REQUIREMENTS, attrStrings(attrs, t.keys.Key(client.COMPLIANCE_REQUIREMENTS))),
+		zap.String(client.COMPLIANCE_STATUS, t.complianceString(attrs, client.COMPLIANCE_STATUS)),
+		zap.String(client.COMPLIANCE_ENRICHMENT_STATUS, t.complianceString(attrs, client.COMPLIANCE_ENRICHMENT_STATUS)),
+	)
+}
+
+// complianceString returns the string value of the compliance attribute
+// written under the configured key for defaultKey.
+func (t *truthBeamProcessor) complianceString(attrs pcommon.Map, defaultKey string) string {
+	return attrString(attrs, t.keys.Key(defaultKey))
+}
+
+// attrString returns the string value of key, or an empty string if unset.
+func attrString(attrs pcommon.Map, key string) string {
+	val, ok := attrs.Get(key)
+	if !ok {
+		return ""
+	}
+	return val.AsString()
+}
+
+// attrStrings returns the string elements of the slice value of key.
+func attrStrings(attrs pcommon.Map, key string) []string {
+	val, ok := attrs.Get(key)
+	if !ok || val.Type() != pcommon.ValueTypeSlice {
+		return nil
+	}
+	slice := val.Slice()
+	values := make([]string, 0, slice.Len())
+	for i := 0; i < slice.Len(); i++ {
+		values = append(values, slice.At(i).AsString())
+	}
+	return values
+}
+
+// isEnriched reports whether the log record or span with attrs has already
+// been through enrichment, judged by the keys the client writes.
+func (t *truthBeamProcessor) isEnriched(attrs pcommon.Map) bool {
+	if _, ok := attrs.Get(t.keys.Key(client.COMPLIANCE_STATUS)); ok {
+		return true
+	}
+	_, ok := attrs.Get(t.keys.Key(client.COMPLIANCE_ENRICHMENT_STATUS))
+	return ok
+}
+
 // start will add HTTP client and pre-fetch any policy data
 func (t *truthBeamProcessor) start(ctx context.Context, host component.Host) error {
 	httpClient, err := t.config.ClientConfig.ToClient(ctx, host, t.telemetry)
 	if err != nil {
 		return err
 	}
-	t.client, err = client.NewClient(t.config.ClientConfig.Endpoint, client.WithHTTPClient(httpClient))
+	endpoints := append([]string{t.config.ClientConfig.Endpoint}, t.config.FailoverEndpoints...)
+	compassClient, err := client.NewFailoverClient(endpoints, client.WithHTTPClient(httpClient))
 	if err != nil {
 		return err
 	}
+	if breaker := t.config.CircuitBreaker; breaker.FailureThreshold > 0 {
+		compassClient.Client = client.NewCircuitBreaker(compassClient.Client, breaker.FailureThreshold, breaker.Cooldown)
+	}
+	opts := []client.ApplierOption{
+		client.WithKeyMapping(t.keys),
+		client.WithStatusVocabulary(t.config.statusVocabulary()),
+	}
+	if t.config.ComplianceFormat != "" {
+		opts = append(opts, client.WithFormat(client.Format(t.config.ComplianceFormat)))
+	}
+	t.client = client.NewApplier(compassClient, opts...)
+	t.httpClient = httpClient
+
+	if t.config.HealthCheck {
+		if err := client.Ping(ctx, compassClient); err != nil {
+			t.logger.Warn("compass is unreachable; log records will not be enriched until it is available",
+				zap.String("endpoint", t.config.ClientConfig.Endpoint),
+				zap.Error(err))
+		}
+	}
+
+	if prefetch := t.config.PrefetchRules; prefetch.Enabled {
+		t.loadRules(ctx, compassClient)
+		if prefetch.RefreshInterval > 0 {
+			t.startRulesRefresher(compassClient, prefetch.RefreshInterval)
+		}
+	}
+
+	if t.config.StatsInterval > 0 {
+		t.startStatsReporter(t.config.StatsInterval)
+	}
 
 	return nil
 }
+
+// loadRules fetches the policy rules compass can map. The rules are only
+// used when compass could list all of them; if they cannot be fetched, the
+// rules loaded previously stay in use.
+func (t *truthBeamProcessor) loadRules(ctx context.Context, compassClient *client.Client) {
+	rules, err := client.FetchRules(ctx, compassClient)
+	if err != nil {
+		t.logger.Warn("failed to load the policy rules compass can map",
+			zap.String("endpoint", t.config.ClientConfig.Endpoint),
+			zap.Error(err))
+		return
+	}
+	if !rules.Complete() {
+		t.logger.Warn("compass cannot list every policy rule it maps; every record will be looked up")
+		t.rules.Store(nil)
+		return
+	}
+	t.rules.Store(rules)
+	t.logger.Info("loaded the policy rules compass can map", zap.Int("rules", rules.Len()))
+}
+
+// startRulesRefresher reloads the policy rules every interval until shutdown.
+func (t *truthBeamProcessor) startRulesRefresher(compassClient *client.Client, interval time.Duration) {
+	t.stopRules = make(chan struct{})
+	stop := t.stopRules
+	t.rulesWG.Add(1)
+	go func() {
+		defer t.rulesWG.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				t.loadRules(context.Background(), compassClient)
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+// shutdown stops the rules refresher and stats reporter, if running, logs
+// any stats not yet reported, and closes idle connections held by the HTTP
+// client.
+func (t *truthBeamProcessor) shutdown(_ context.Context) error {
+	if t.stopRules != nil {
+		close(t.stopRules)
+		t.stopRules = nil
+	}
+	t.rulesWG.Wait()
+
+	if t.stopStats != nil {
+		close(t.stopStats)
+		t.stopStats = nil
+	}
+	t.statsWG.Wait()
+	t.reportStats()
+
+	if t.httpClient != nil {
+		t.httpClient.CloseIdleConnections()
+	}
+	return nil
+}
+
+// startStatsReporter logs rolling enrichment stats every interval until shutdown.
+func (t *truthBeamProcessor) startStatsReporter(interval time.Duration) {
+	t.stopStats = make(chan struct{})
+	stop := t.stopStats
+	t.statsWG.Add(1)
+	go func() {
+		defer t.statsWG.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				t.reportStats()
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+// reportStats logs and resets the enrichment counters. Intervals without
+// any processed records are not reported. Records marked unmapped from the
+// prefetched rules count as successes.
+func (t *truthBeamProcessor) reportStats() {
+	enriched := t.stats.enriched.Swap(0)
+	failed := t.stats.failed.Swap(0)
+	unmapped := t.stats.unmapped.Swap(0)
+	total := enriched + failed + unmapped
+	if total == 0 {
+		return
+	}
+	t.logger.Info("compliance enrichment stats",
+		zap.Int64("enriched", enriched),
+		zap.Int64("unmapped", unmapped),
+		zap.Int64("failed", failed),
+		zap.Float64("success_rate", float64(enriched+unmapped)/float64(total)),
+	)
+}
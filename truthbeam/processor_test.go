@@ -3,8 +3,11 @@ package truthbeam
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -15,8 +18,12 @@ import (
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor/processortest"
+	"go.uber.org/goleak"
+	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
+	"go.uber.org/zap/zaptest/observer"
 
 	"github.com/complytime/complybeacon/truthbeam/internal/client"
 )
@@ -119,6 +126,85 @@ func TestProcessLogs(t *testing.T) {
 	assert.Contains(t, standards, "ISO-27001")
 }
 
+func TestProcessTraces(t *testing.T) {
+	var requests atomic.Int32
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		var req client.EnrichmentRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "test-policy-123", req.Evidence.PolicyRuleId)
+		assert.Equal(t, "test-source", req.Evidence.PolicyEngineName)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(client.EnrichmentResponse{
+			Compliance: client.Compliance{
+				Control: client.ComplianceControl{
+					CatalogId: "NIST-800-53",
+					Category:  "Access Control",
+					Id:        "AC-1",
+				},
+				Frameworks: client.ComplianceFrameworks{
+					Requirements: []string{"req-1"},
+					Frameworks:   []string{"NIST-800-53"},
+				},
+				Status:           client.ComplianceStatusCompliant,
+				EnrichmentStatus: client.ComplianceEnrichmentStatusSuccess,
+			},
+		})
+	}))
+	defer mockServer.Close()
+
+	processor := createTestProcessor(t, mockServer.URL)
+
+	traces := ptrace.NewTraces()
+	spans := traces.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	policySpan := spans.AppendEmpty()
+	policySpan.SetName("policy.evaluate")
+	policySpan.SetEndTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	policySpan.Attributes().PutStr(client.POLICY_RULE_ID, "test-policy-123")
+	policySpan.Attributes().PutStr(client.POLICY_ENGINE_NAME, "test-source")
+	policySpan.Attributes().PutStr(client.POLICY_EVALUATION_RESULT, "Passed")
+
+	appSpan := spans.AppendEmpty()
+	appSpan.SetName("GET /healthz")
+	appSpan.Attributes().PutStr("http.route", "/healthz")
+
+	enrichedSpan := spans.AppendEmpty()
+	enrichedSpan.SetName("policy.evaluate")
+	enrichedSpan.Attributes().PutStr(client.POLICY_RULE_ID, "test-policy-123")
+	enrichedSpan.Attributes().PutStr(client.COMPLIANCE_STATUS, "Non-Compliant")
+
+	result, err := processor.processTraces(context.Background(), traces)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), requests.Load(), "only the unenriched policy span should be looked up")
+
+	resultSpans := result.ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+	attrs := resultSpans.At(0).Attributes().AsRaw()
+	assert.Equal(t, "Compliant", attrs[client.COMPLIANCE_STATUS])
+	assert.Equal(t, "AC-1", attrs[client.COMPLIANCE_CONTROL_ID])
+	assert.Equal(t, []any{"NIST-800-53"}, attrs[client.COMPLIANCE_FRAMEWORKS])
+
+	assert.Equal(t, map[string]any{"http.route": "/healthz"}, resultSpans.At(1).Attributes().AsRaw(),
+		"spans without a policy rule id are left untouched")
+	assert.Equal(t, "Non-Compliant", resultSpans.At(2).Attributes().AsRaw()[client.COMPLIANCE_STATUS])
+}
+
+func TestProcessTracesFailOnError(t *testing.T) {
+	fake := &fakeEnrichmentClient{err: errors.New("compass unavailable")}
+	processor := createTestProcessor(t, "http://localhost:8081")
+	processor.client = fake
+	processor.config.FailOnError = true
+
+	traces := ptrace.NewTraces()
+	span := traces.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.Attributes().PutStr(client.POLICY_RULE_ID, "test-policy-123")
+
+	result, err := processor.processTraces(context.Background(), traces)
+	require.Error(t, err)
+	assert.Equal(t, 1, fake.calls)
+	assert.Equal(t, 1, result.SpanCount(), "the batch is kept either way")
+}
+
 func TestProcessLogsWithMissingAttributes(t *testing.T) {
 	processor := createTestProcessor(t, "http://localhost:8081")
 	logs := createTestLogs()
@@ -246,6 +332,777 @@ func TestProcessLogsWithMixedValidAndInvalidRecords(t *testing.T) {
 	assert.Equal(t, "NIST-800-53", attrs3.AsRaw()[client.COMPLIANCE_CONTROL_CATALOG_ID])
 }
 
+// fakeEnrichmentClient marks records compliant without calling compass.
+type fakeEnrichmentClient struct {
+	calls int
+	err   error
+}
+
+func (f *fakeEnrichmentClient) ApplyAttributes(_ context.Context, _ pcommon.Resource, logRecord plog.LogRecord) error {
+	return f.apply(logRecord.Attributes())
+}
+
+func (f *fakeEnrichmentClient) ApplySpanAttributes(_ context.Context, _ pcommon.Resource, span ptrace.Span) error {
+	return f.apply(span.Attributes())
+}
+
+func (f *fakeEnrichmentClient) ApplyUnmappedAttributes(logRecord plog.LogRecord, _ string) error {
+	return f.apply(logRecord.Attributes())
+}
+
+func (f *fakeEnrichmentClient) ApplyUnmappedSpanAttributes(span ptrace.Span, _ string) error {
+	return f.apply(span.Attributes())
+}
+
+func (f *fakeEnrichmentClient) apply(attrs pcommon.Map) error {
+	f.calls++
+	if f.err != nil {
+		return f.err
+	}
+	attrs.PutStr(client.COMPLIANCE_STATUS, string(client.ComplianceStatusCompliant))
+	return nil
+}
+
+func TestProcessLogsSkipsEnrichedRecords(t *testing.T) {
+	tests := []struct {
+		name             string
+		forceReenrich    bool
+		expectedRequests int
+		expectedStatus   string
+	}{
+		{
+			name:             "pre-enriched record is left untouched by default",
+			forceReenrich:    false,
+			expectedRequests: 0,
+			expectedStatus:   "Non-Compliant",
+		},
+		{
+			name:             "pre-enriched record is re-processed when forced",
+			forceReenrich:    true,
+			expectedRequests: 1,
+			expectedStatus:   "Compliant",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeEnrichmentClient{}
+			processor := createTestProcessor(t, "http://localhost:8081")
+			processor.client = fake
+			processor.config.ForceReenrich = tt.forceReenrich
+
+			logs := createTestLogs()
+			setRequiredAttributes(logs)
+			logRecord := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+			logRecord.Attributes().PutStr(client.COMPLIANCE_STATUS, "Non-Compliant")
+			logRecord.Attributes().PutStr(client.COMPLIANCE_ENRICHMENT_STATUS, string(client.ComplianceEnrichmentStatusSuccess))
+
+			result, err := processor.processLogs(context.Background(), logs)
+			require.NoError(t, err)
+
+			attrs := result.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+			assert.Equal(t, tt.expectedRequests, fake.calls)
+			assert.Equal(t, tt.expectedStatus, attrs.AsRaw()[client.COMPLIANCE_STATUS])
+		})
+	}
+}
+
+func TestProcessLogsAttributeKeyMapping(t *testing.T) {
+	var requests atomic.Int32
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(client.EnrichmentResponse{
+			Compliance: client.Compliance{
+				Control:          client.ComplianceControl{CatalogId: "NIST-800-53", Id: "AC-1"},
+				Status:           client.ComplianceStatusCompliant,
+				EnrichmentStatus: client.ComplianceEnrichmentStatusSuccess,
+			},
+		})
+	}))
+	defer mockServer.Close()
+
+	cfg := &Config{
+		ClientConfig:          confighttp.NewDefaultClientConfig(),
+		AttributeKeyPrefix:    "acme.",
+		AttributeKeyOverrides: map[string]string{client.COMPLIANCE_CONTROL_ID: "control"},
+	}
+	cfg.ClientConfig.Endpoint = mockServer.URL
+	processor, err := newTruthBeamProcessor(cfg, processortest.NewNopSettings(component.MustNewType("test")))
+	require.NoError(t, err)
+	require.NoError(t, processor.start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { assert.NoError(t, processor.shutdown(context.Background())) })
+
+	logs := createTestLogs()
+	setRequiredAttributes(logs)
+	result, err := processor.processLogs(context.Background(), logs)
+	require.NoError(t, err)
+
+	attrs := result.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().AsRaw()
+	assert.Equal(t, "Compliant", attrs["acme."+client.COMPLIANCE_STATUS])
+	assert.Equal(t, "AC-1", attrs["control"])
+	assert.NotContains(t, attrs, client.COMPLIANCE_STATUS)
+
+	// A second pass recognizes the renamed keys and skips the record.
+	_, err = processor.processLogs(context.Background(), result)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestProcessLogsStatusVocabulary(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(client.EnrichmentResponse{
+			Compliance: client.Compliance{
+				Control:          client.ComplianceControl{CatalogId: "NIST-800-53", Id: "AC-1"},
+				Status:           client.ComplianceStatusNonCompliant,
+				EnrichmentStatus: client.ComplianceEnrichmentStatusSuccess,
+			},
+		})
+	}))
+	defer mockServer.Close()
+
+	cfg := &Config{
+		ClientConfig:     confighttp.NewDefaultClientConfig(),
+		StatusVocabulary: map[string]string{"Compliant": "PASS", "Non-Compliant": "FAIL"},
+	}
+	cfg.ClientConfig.Endpoint = mockServer.URL
+	processor, err := newTruthBeamProcessor(cfg, processortest.NewNopSettings(component.MustNewType("test")))
+	require.NoError(t, err)
+	require.NoError(t, processor.start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { assert.NoError(t, processor.shutdown(context.Background())) })
+
+	logs := createTestLogs()
+	setRequiredAttributes(logs)
+	result, err := processor.processLogs(context.Background(), logs)
+	require.NoError(t, err)
+
+	attrs := result.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().AsRaw()
+	assert.Equal(t, "FAIL", attrs[client.COMPLIANCE_STATUS])
+}
+
+func TestProcessLogsComplianceJSONBody(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(client.EnrichmentResponse{
+			Compliance: client.Compliance{
+				Control: client.ComplianceControl{
+					CatalogId: "NIST-800-53",
+					Category:  "Access Control",
+					Id:        "AC-1",
+				},
+				Frameworks: client.ComplianceFrameworks{
+					Frameworks:   []string{"NIST-800-53"},
+					Requirements: []string{"AC-1"},
+				},
+				Status:           client.ComplianceStatusNonCompliant,
+				EnrichmentStatus: client.ComplianceEnrichmentStatusSuccess,
+			},
+			MapperId: stringPtr("basic"),
+		})
+	}))
+	defer mockServer.Close()
+
+	cfg := &Config{
+		ClientConfig:     confighttp.NewDefaultClientConfig(),
+		ComplianceFormat: "json_body",
+	}
+	cfg.ClientConfig.Endpoint = mockServer.URL
+	processor, err := newTruthBeamProcessor(cfg, processortest.NewNopSettings(component.MustNewType("test")))
+	require.NoError(t, err)
+	require.NoError(t, processor.start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { assert.NoError(t, processor.shutdown(context.Background())) })
+
+	logs := createTestLogs()
+	setRequiredAttributes(logs)
+	logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().SetStr("raw evidence")
+	result, err := processor.processLogs(context.Background(), logs)
+	require.NoError(t, err)
+
+	logRecord := result.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	body := logRecord.Body().Map().AsRaw()
+	assert.Equal(t, "raw evidence", body["body"], "original body is kept")
+	assert.JSONEq(t, `{
+		"compliance": {
+			"control": {"catalogId": "NIST-800-53", "category": "Access Control", "id": "AC-1"},
+			"frameworks": {"frameworks": ["NIST-800-53"], "requirements": ["AC-1"]},
+			"status": "Non-Compliant",
+			"enrichmentStatus": "Success"
+		},
+		"mapperId": "basic"
+	}`, body["compliance"].(string))
+
+	attrs := logRecord.Attributes().AsRaw()
+	assert.Equal(t, "Success", attrs[client.COMPLIANCE_ENRICHMENT_STATUS])
+	assert.Equal(t, "basic", attrs[client.COMPLIANCE_MAPPER_ID], "shared attributes are written in every format")
+	assert.NotContains(t, attrs, client.COMPLIANCE_STATUS, "compliance fields are not written as attributes")
+	assert.NotContains(t, attrs, client.COMPLIANCE_CONTROL_ID)
+}
+
+func TestProcessLogsContinuesAfterEnrichmentError(t *testing.T) {
+	fake := &fakeEnrichmentClient{err: errors.New("compass unavailable")}
+	processor := createTestProcessor(t, "http://localhost:8081")
+	processor.client = fake
+
+	logs := createTestLogs()
+	setRequiredAttributes(logs)
+	scopeLogs := logs.ResourceLogs().At(0).ScopeLogs().At(0)
+	scopeLogs.LogRecords().At(0).CopyTo(scopeLogs.LogRecords().AppendEmpty())
+
+	result, err := processor.processLogs(context.Background(), logs)
+	require.NoError(t, err, "enrichment errors must not drop the batch")
+
+	assert.Equal(t, 2, fake.calls, "every record should be attempted")
+	assert.Equal(t, 2, result.LogRecordCount())
+}
+
+func TestProcessLogsFailOnError(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		_ = json.NewEncoder(w).Encode(client.Error{Code: http.StatusInternalServerError, Message: "Internal server error"})
+	}))
+	defer mockServer.Close()
+
+	tests := []struct {
+		name        string
+		failOnError bool
+		expectError bool
+	}{
+		{
+			name:        "errors are logged by default",
+			failOnError: false,
+			expectError: false,
+		},
+		{
+			name:        "errors fail the batch when enabled",
+			failOnError: true,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			processor := createTestProcessor(t, mockServer.URL)
+			processor.config.FailOnError = tt.failOnError
+
+			logs := createTestLogs()
+			setRequiredAttributes(logs)
+			scopeLogs := logs.ResourceLogs().At(0).ScopeLogs().At(0)
+			scopeLogs.LogRecords().At(0).CopyTo(scopeLogs.LogRecords().AppendEmpty())
+
+			result, err := processor.processLogs(context.Background(), logs)
+			if tt.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "API call failed with status 500")
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, 2, result.LogRecordCount(), "the batch is processed in full either way")
+		})
+	}
+}
+
+func TestProcessLogsEnrichmentTimeout(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(2 * time.Second):
+		}
+	}))
+	defer mockServer.Close()
+
+	processor := createTestProcessor(t, mockServer.URL)
+	processor.config.EnrichmentTimeout = 50 * time.Millisecond
+
+	logs := createTestLogs()
+	setRequiredAttributes(logs)
+
+	start := time.Now()
+	result, err := processor.processLogs(context.Background(), logs)
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), time.Second, "per-call timeout should stop the slow lookup")
+
+	attrs := result.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+	_, hasStatus := attrs.Get(client.COMPLIANCE_STATUS)
+	assert.False(t, hasStatus, "timed out record should not be enriched")
+}
+
+// blockingEnrichmentClient holds every call until released and tracks how
+// many calls are in flight at once.
+type blockingEnrichmentClient struct {
+	entered     chan struct{}
+	release     chan struct{}
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+	calls       atomic.Int32
+}
+
+func (f *blockingEnrichmentClient) ApplyAttributes(_ context.Context, _ pcommon.Resource, _ plog.LogRecord) error {
+	return f.apply()
+}
+
+func (f *blockingEnrichmentClient) ApplySpanAttributes(_ context.Context, _ pcommon.Resource, _ ptrace.Span) error {
+	return f.apply()
+}
+
+func (f *blockingEnrichmentClient) ApplyUnmappedAttributes(_ plog.LogRecord, _ string) error {
+	return f.apply()
+}
+
+func (f *blockingEnrichmentClient) ApplyUnmappedSpanAttributes(_ ptrace.Span, _ string) error {
+	return f.apply()
+}
+
+func (f *blockingEnrichmentClient) apply() error {
+	f.calls.Add(1)
+	n := f.inFlight.Add(1)
+	defer f.inFlight.Add(-1)
+	for {
+		current := f.maxInFlight.Load()
+		if n <= current || f.maxInFlight.CompareAndSwap(current, n) {
+			break
+		}
+	}
+	f.entered <- struct{}{}
+	<-f.release
+	return nil
+}
+
+func TestProcessLogsMaxConcurrentRequests(t *testing.T) {
+	const (
+		limit   = 3
+		batches = 10
+	)
+	cfg := &Config{
+		ClientConfig:          confighttp.NewDefaultClientConfig(),
+		MaxConcurrentRequests: limit,
+	}
+	processor, err := newTruthBeamProcessor(cfg, processortest.NewNopSettings(component.MustNewType("test")))
+	require.NoError(t, err)
+	fake := &blockingEnrichmentClient{
+		entered: make(chan struct{}, batches),
+		release: make(chan struct{}),
+	}
+	processor.client = fake
+
+	var wg sync.WaitGroup
+	for range batches {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			logs := createTestLogs()
+			setRequiredAttributes(logs)
+			_, err := processor.processLogs(context.Background(), logs)
+			assert.NoError(t, err)
+		}()
+	}
+
+	for range limit {
+		<-fake.entered
+	}
+	select {
+	case <-fake.entered:
+		t.Fatal("more enrichment calls in flight than the configured limit")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(fake.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(limit), fake.maxInFlight.Load())
+	assert.Equal(t, int32(batches), fake.calls.Load(), "waiting records should still be enriched")
+}
+
+func TestProcessLogsWaitingForSlotRespectsCancellation(t *testing.T) {
+	cfg := &Config{
+		ClientConfig:          confighttp.NewDefaultClientConfig(),
+		MaxConcurrentRequests: 1,
+	}
+	processor, err := newTruthBeamProcessor(cfg, processortest.NewNopSettings(component.MustNewType("test")))
+	require.NoError(t, err)
+	fake := &fakeEnrichmentClient{}
+	processor.client = fake
+	processor.requests <- struct{}{} // occupy the only slot
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	processor.config.FailOnError = true
+
+	logs := createTestLogs()
+	setRequiredAttributes(logs)
+	_, err = processor.processLogs(ctx, logs)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Zero(t, fake.calls, "the record should not be sent while no slot is free")
+}
+
+func TestStartHealthCheck(t *testing.T) {
+	reachable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer reachable.Close()
+
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachableURL := unreachable.URL
+	unreachable.Close()
+
+	tests := []struct {
+		name         string
+		endpoint     string
+		healthCheck  bool
+		expectedWarn int
+	}{
+		{
+			name:         "reachable endpoint",
+			endpoint:     reachable.URL,
+			healthCheck:  true,
+			expectedWarn: 0,
+		},
+		{
+			name:         "unreachable endpoint",
+			endpoint:     unreachableURL,
+			healthCheck:  true,
+			expectedWarn: 1,
+		},
+		{
+			name:         "unreachable endpoint without health check",
+			endpoint:     unreachableURL,
+			healthCheck:  false,
+			expectedWarn: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				ClientConfig: confighttp.NewDefaultClientConfig(),
+				HealthCheck:  tt.healthCheck,
+			}
+			cfg.ClientConfig.Endpoint = tt.endpoint
+
+			core, observed := observer.New(zap.WarnLevel)
+			settings := processortest.NewNopSettings(component.MustNewType("test"))
+			settings.Logger = zap.New(core)
+
+			processor, err := newTruthBeamProcessor(cfg, settings)
+			require.NoError(t, err)
+
+			err = processor.start(context.Background(), componenttest.NewNopHost())
+			require.NoError(t, err, "health check must not fail startup")
+			assert.Equal(t, tt.expectedWarn, observed.FilterMessageSnippet("compass is unreachable").Len())
+		})
+	}
+}
+
+// rulesServer is a compass stub that serves GET /v1/rules from a swappable
+// response and counts the enrichment requests it receives.
+type rulesServer struct {
+	*httptest.Server
+	mu          sync.Mutex
+	rules       *client.RulesResponse
+	enrichCalls atomic.Int32
+}
+
+func newRulesServer(t *testing.T, rules *client.RulesResponse) *rulesServer {
+	s := &rulesServer{rules: rules}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/rules":
+			s.mu.Lock()
+			rules := s.rules
+			s.mu.Unlock()
+			if rules == nil {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(rules)
+		case "/v1/enrich":
+			s.enrichCalls.Add(1)
+			_ = json.NewEncoder(w).Encode(client.EnrichmentResponse{
+				Compliance: client.Compliance{
+					Control:          client.ComplianceControl{Id: "AC-1", CatalogId: "NIST-800-53"},
+					Status:           client.ComplianceStatusCompliant,
+					EnrichmentStatus: client.ComplianceEnrichmentStatusSuccess,
+				},
+			})
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *rulesServer) setRules(rules *client.RulesResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rules = rules
+}
+
+// createPrefetchProcessor starts a processor that prefetches rules from server.
+func createPrefetchProcessor(t *testing.T, server *rulesServer, refresh time.Duration) *truthBeamProcessor {
+	cfg := &Config{
+		ClientConfig:  confighttp.NewDefaultClientConfig(),
+		PrefetchRules: PrefetchRulesConfig{Enabled: true, RefreshInterval: refresh},
+	}
+	cfg.ClientConfig.Endpoint = server.URL
+
+	settings := processortest.NewNopSettings(component.MustNewType("test"))
+	settings.Logger = zaptest.NewLogger(t)
+	processor, err := newTruthBeamProcessor(cfg, settings)
+	require.NoError(t, err)
+	require.NoError(t, processor.start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { assert.NoError(t, processor.shutdown(context.Background())) })
+	return processor
+}
+
+// enrichRule runs a single record for rule through the processor and returns
+// its enrichment status.
+func enrichRule(t *testing.T, processor *truthBeamProcessor, rule string) string {
+	logs := createTestLogs()
+	setRequiredAttributes(logs)
+	logRecord := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	logRecord.Attributes().PutStr(client.POLICY_RULE_ID, rule)
+
+	result, err := processor.processLogs(context.Background(), logs)
+	require.NoError(t, err)
+	attrs := result.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+	return attrString(attrs, client.COMPLIANCE_ENRICHMENT_STATUS)
+}
+
+func TestProcessLogsPrefetchRules(t *testing.T) {
+	tests := []struct {
+		name                string
+		rules               *client.RulesResponse
+		expectedStatus      string
+		expectedEnrichCalls int32
+	}{
+		{
+			name: "unknown rule is unmapped without a lookup",
+			rules: &client.RulesResponse{
+				Engines:       map[string][]string{"test-source": {"test-policy-123"}},
+				FallbackRules: []string{},
+				Complete:      true,
+			},
+			expectedStatus:      string(client.ComplianceEnrichmentStatusUnmapped),
+			expectedEnrichCalls: 0,
+		},
+		{
+			name: "unknown rule is looked up when the list is incomplete",
+			rules: &client.RulesResponse{
+				Engines:       map[string][]string{"test-source": {"test-policy-123"}},
+				FallbackRules: []string{},
+				Complete:      false,
+			},
+			expectedStatus:      string(client.ComplianceEnrichmentStatusSuccess),
+			expectedEnrichCalls: 1,
+		},
+		{
+			name:                "unknown rule is looked up when the list cannot be loaded",
+			rules:               nil,
+			expectedStatus:      string(client.ComplianceEnrichmentStatusSuccess),
+			expectedEnrichCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newRulesServer(t, tt.rules)
+			processor := createPrefetchProcessor(t, server, 0)
+
+			assert.Equal(t, tt.expectedStatus, enrichRule(t, processor, "unknown-policy"))
+			assert.Equal(t, tt.expectedEnrichCalls, server.enrichCalls.Load())
+
+			assert.Equal(t, string(client.ComplianceEnrichmentStatusSuccess), enrichRule(t, processor, "test-policy-123"),
+				"known rules are always looked up")
+		})
+	}
+}
+
+func TestProcessLogsPrefetchRulesUnmapped(t *testing.T) {
+	server := newRulesServer(t, &client.RulesResponse{
+		Engines:           map[string][]string{"test-source": {"test-policy-123"}},
+		FallbackRules:     []string{},
+		Complete:          true,
+		UnmappedMapperIds: &map[string]string{"test-source": "cel"},
+		UnmappedMapperId:  stringPtr("basic"),
+	})
+	processor := createPrefetchProcessor(t, server, 0)
+
+	logs := createTestLogs()
+	setRequiredAttributes(logs)
+	logRecord := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	logRecord.Attributes().PutStr(client.POLICY_RULE_ID, "unknown-policy")
+	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(time.Now().Add(-2 * time.Second)))
+
+	result, err := processor.processLogs(context.Background(), logs)
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), server.enrichCalls.Load())
+
+	attrs := result.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+	assert.Equal(t, string(client.ComplianceEnrichmentStatusUnmapped), attrString(attrs, client.COMPLIANCE_ENRICHMENT_STATUS))
+	assert.Equal(t, "cel", attrString(attrs, client.COMPLIANCE_MAPPER_ID))
+	lag, ok := attrs.Get(client.COMPLIANCE_ENRICHMENT_LAG_MS)
+	require.True(t, ok)
+	assert.GreaterOrEqual(t, lag.Int(), int64(2000))
+
+	assert.Equal(t, int64(0), processor.stats.enriched.Load())
+	assert.Equal(t, int64(1), processor.stats.unmapped.Load())
+	assert.Equal(t, int64(0), processor.stats.failed.Load())
+}
+
+func TestProcessLogsPrefetchRulesRefresh(t *testing.T) {
+	server := newRulesServer(t, &client.RulesResponse{
+		Engines:       map[string][]string{"test-source": {}},
+		FallbackRules: []string{},
+		Complete:      true,
+	})
+	processor := createPrefetchProcessor(t, server, 10*time.Millisecond)
+	require.Equal(t, string(client.ComplianceEnrichmentStatusUnmapped), enrichRule(t, processor, "test-policy-123"))
+
+	server.setRules(&client.RulesResponse{
+		Engines:       map[string][]string{"test-source": {"test-policy-123"}},
+		FallbackRules: []string{},
+		Complete:      true,
+	})
+	assert.Eventually(t, func() bool {
+		rules := processor.rules.Load()
+		return rules != nil && rules.Maps("test-source", "test-policy-123")
+	}, time.Second, 10*time.Millisecond, "refreshed rules should be picked up")
+	assert.Equal(t, string(client.ComplianceEnrichmentStatusSuccess), enrichRule(t, processor, "test-policy-123"))
+}
+
+func TestProcessLogsAuditLog(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(client.EnrichmentResponse{
+			Compliance: client.Compliance{
+				Control: client.ComplianceControl{
+					CatalogId: "NIST-800-53",
+					Category:  "Access Control",
+					Id:        "AC-1",
+				},
+				Frameworks: client.ComplianceFrameworks{
+					Requirements: []string{"req-1", "req-2"},
+					Frameworks:   []string{"NIST-800-53"},
+				},
+				Status:           client.ComplianceStatusCompliant,
+				EnrichmentStatus: client.ComplianceEnrichmentStatusSuccess,
+			},
+		})
+	}))
+	defer mockServer.Close()
+
+	for _, auditLog := range []bool{true, false} {
+		core, observed := observer.New(zap.InfoLevel)
+		processor := createTestProcessor(t, mockServer.URL)
+		processor.logger = zap.New(core)
+		processor.config.AuditLog = auditLog
+
+		logs := createTestLogs()
+		setRequiredAttributes(logs)
+
+		_, err := processor.processLogs(context.Background(), logs)
+		require.NoError(t, err)
+
+		entries := observed.FilterMessage("compliance enrichment applied").All()
+		if !auditLog {
+			assert.Empty(t, entries, "audit log should be off by default")
+			continue
+		}
+
+		require.Len(t, entries, 1)
+		fields := entries[0].ContextMap()
+		assert.Equal(t, "test-policy-123", fields[client.POLICY_RULE_ID])
+		assert.Equal(t, "test-source", fields[client.POLICY_ENGINE_NAME])
+		assert.Equal(t, "AC-1", fields[client.COMPLIANCE_CONTROL_ID])
+		assert.Equal(t, "NIST-800-53", fields[client.COMPLIANCE_CONTROL_CATALOG_ID])
+		assert.Equal(t, []interface{}{"NIST-800-53"}, fields[client.COMPLIANCE_FRAMEWORKS])
+		assert.Equal(t, []interface{}{"req-1", "req-2"}, fields[client.COMPLIANCE_REQUIREMENTS])
+		assert.Equal(t, string(client.ComplianceStatusCompliant), fields[client.COMPLIANCE_STATUS])
+		assert.Equal(t, string(client.ComplianceEnrichmentStatusSuccess), fields[client.COMPLIANCE_ENRICHMENT_STATUS])
+	}
+}
+
+func TestStatsReporterStopsOnShutdown(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer mockServer.Close()
+
+	core, observed := observer.New(zap.InfoLevel)
+	processor := createTestProcessor(t, mockServer.URL)
+	processor.logger = zap.New(core)
+	processor.config.StatsInterval = 10 * time.Millisecond
+
+	require.NoError(t, processor.start(context.Background(), componenttest.NewNopHost()))
+
+	processor.stats.enriched.Add(2)
+	processor.stats.unmapped.Add(1)
+	processor.stats.failed.Add(1)
+	require.Eventually(t, func() bool {
+		return observed.FilterMessage("compliance enrichment stats").Len() == 1
+	}, time.Second, 5*time.Millisecond)
+
+	fields := observed.FilterMessage("compliance enrichment stats").All()[0].ContextMap()
+	assert.Equal(t, int64(2), fields["enriched"])
+	assert.Equal(t, int64(1), fields["unmapped"])
+	assert.Equal(t, int64(1), fields["failed"])
+	assert.Equal(t, 0.75, fields["success_rate"])
+
+	require.NoError(t, processor.shutdown(context.Background()))
+	assert.Nil(t, processor.stopStats)
+
+	// No further reports once the reporter has exited.
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 1, observed.FilterMessage("compliance enrichment stats").Len())
+
+	// Shutdown is idempotent.
+	require.NoError(t, processor.shutdown(context.Background()))
+}
+
+func TestShutdownFlushesStats(t *testing.T) {
+	core, observed := observer.New(zap.InfoLevel)
+	processor := createTestProcessor(t, "http://localhost:8081")
+	processor.logger = zap.New(core)
+
+	processor.stats.enriched.Add(2)
+	require.NoError(t, processor.shutdown(context.Background()))
+
+	entries := observed.FilterMessage("compliance enrichment stats").All()
+	require.Len(t, entries, 1)
+	assert.Equal(t, int64(2), entries[0].ContextMap()["enriched"])
+}
+
+func TestShutdownDoesNotLeakGoroutines(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(client.EnrichmentResponse{
+			Compliance: client.Compliance{
+				Status:           client.ComplianceStatusCompliant,
+				EnrichmentStatus: client.ComplianceEnrichmentStatusSuccess,
+			},
+		})
+	}))
+	ignore := goleak.IgnoreCurrent()
+
+	processor := createTestProcessor(t, mockServer.URL)
+	processor.config.StatsInterval = 10 * time.Millisecond
+	require.NoError(t, processor.start(context.Background(), componenttest.NewNopHost()))
+
+	logs := createTestLogs()
+	setRequiredAttributes(logs)
+	_, err := processor.processLogs(context.Background(), logs)
+	require.NoError(t, err)
+
+	require.NoError(t, processor.shutdown(context.Background()))
+	mockServer.Close()
+	goleak.VerifyNone(t, ignore)
+}
+
+func TestShutdownWithoutStart(t *testing.T) {
+	processor := createTestProcessor(t, "http://localhost:8081")
+	assert.NoError(t, processor.shutdown(context.Background()))
+}
+
 // Helper functions
 func createTestProcessor(t *testing.T, endpoint string) *truthBeamProcessor {
 	cfg := &Config{
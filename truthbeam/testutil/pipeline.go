@@ -0,0 +1,203 @@
+// Package testutil provides an in-memory harness for testing the full
+// enrichment pipeline: evidence logged through proofwatch is enriched by an
+// in-process compass service and written back by the truthbeam applier,
+// without starting a server or opening a network connection.
+package testutil
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	olog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/noop"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/complytime/complybeacon/compass/cmd/compass/server"
+	"github.com/complytime/complybeacon/compass/mapper"
+	compass "github.com/complytime/complybeacon/compass/service"
+	"github.com/complytime/complybeacon/proofwatch"
+	"github.com/complytime/complybeacon/truthbeam/internal/client"
+)
+
+// serverURL is the base URL of the in-process compass service. Requests
+// never leave the process, so the host is only used for routing.
+const serverURL = "http://compass.test"
+
+// maxBodyBytes matches the default request body limit of the compass server.
+const maxBodyBytes = 1 << 20
+
+// ErrNoRecord is returned when proofwatch did not emit a record for the
+// evidence, for example because it was sampled out.
+var ErrNoRecord = errors.New("proofwatch emitted no log record")
+
+// Pipeline wires proofwatch, compass, and the truthbeam applier together.
+type Pipeline struct {
+	// Service is the compass service that maps the evidence.
+	Service *compass.Service
+	// ProofWatch emits the evidence as log records.
+	ProofWatch *proofwatch.ProofWatch
+	// Client calls the compass service in process.
+	Client *client.Client
+	// Applier writes the compliance attributes into the log records.
+	Applier *client.Applier
+
+	logs *recordingLoggerProvider
+}
+
+// NewPipeline returns a Pipeline whose compass service maps evidence with the
+// given mappers and scope. The service is served through the same router,
+// middleware, and request validation as the compass server.
+func NewPipeline(t testing.TB, set mapper.Set, scope mapper.Scope, opts ...compass.Option) *Pipeline {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	service := compass.NewService(set, scope, opts...)
+	handler := server.NewGinServer(service, "", maxBodyBytes).Handler
+
+	c, err := NewInProcessClient(handler)
+	if err != nil {
+		t.Fatalf("creating in-process compass client: %v", err)
+	}
+
+	logs := &recordingLoggerProvider{}
+	pw, err := proofwatch.NewProofWatch(
+		proofwatch.WithLoggerProvider(logs),
+		proofwatch.WithMeterProvider(metricnoop.NewMeterProvider()),
+		proofwatch.WithTracerProvider(tracenoop.NewTracerProvider()),
+	)
+	if err != nil {
+		t.Fatalf("creating proofwatch: %v", err)
+	}
+
+	return &Pipeline{
+		Service:    service,
+		ProofWatch: pw,
+		Client:     c,
+		Applier:    client.NewApplier(c),
+		logs:       logs,
+	}
+}
+
+// NewInProcessClient returns a compass client that sends every request
+// directly to handler instead of over the network.
+func NewInProcessClient(handler http.Handler, opts ...client.ClientOption) (*client.Client, error) {
+	opts = append([]client.ClientOption{client.WithHTTPClient(handlerDoer{handler: handler})}, opts...)
+	return client.NewClient(serverURL, opts...)
+}
+
+// Enrich logs evidence through proofwatch, enriches the emitted record, and
+// returns its final attributes.
+func (p *Pipeline) Enrich(ctx context.Context, evidence proofwatch.Evidence) (map[string]any, error) {
+	logRecord, err := p.EnrichRecord(ctx, evidence)
+	if err != nil {
+		return nil, err
+	}
+	return logRecord.Attributes().AsRaw(), nil
+}
+
+// EnrichRecord logs evidence through proofwatch, enriches the emitted record,
+// and returns it.
+func (p *Pipeline) EnrichRecord(ctx context.Context, evidence proofwatch.Evidence) (plog.LogRecord, error) {
+	before := len(p.logs.recordedLogs())
+	if err := p.ProofWatch.Log(ctx, evidence); err != nil {
+		return plog.LogRecord{}, err
+	}
+	records := p.logs.recordedLogs()
+	if len(records) == before {
+		return plog.LogRecord{}, ErrNoRecord
+	}
+
+	logRecord := plog.NewLogRecord()
+	copyRecord(records[len(records)-1], logRecord)
+	if err := p.Applier.ApplyAttributes(ctx, pcommon.NewResource(), logRecord); err != nil {
+		return plog.LogRecord{}, err
+	}
+	return logRecord, nil
+}
+
+// handlerDoer serves requests with an http.Handler.
+type handlerDoer struct {
+	handler http.Handler
+}
+
+func (d handlerDoer) Do(req *http.Request) (*http.Response, error) {
+	recorder := httptest.NewRecorder()
+	d.handler.ServeHTTP(recorder, req)
+	return recorder.Result(), nil
+}
+
+// recordingLoggerProvider captures every log record proofwatch emits.
+type recordingLoggerProvider struct {
+	noop.LoggerProvider
+	mu      sync.Mutex
+	records []olog.Record
+}
+
+func (p *recordingLoggerProvider) Logger(string, ...olog.LoggerOption) olog.Logger {
+	return &recordingLogger{provider: p}
+}
+
+// recordedLogs returns a snapshot of the records emitted so far.
+func (p *recordingLoggerProvider) recordedLogs() []olog.Record {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]olog.Record(nil), p.records...)
+}
+
+type recordingLogger struct {
+	noop.Logger
+	provider *recordingLoggerProvider
+}
+
+func (l *recordingLogger) Emit(_ context.Context, record olog.Record) {
+	l.provider.mu.Lock()
+	defer l.provider.mu.Unlock()
+	l.provider.records = append(l.provider.records, record.Clone())
+}
+
+// copyRecord converts an emitted record to the collector representation
+// truthbeam processes.
+func copyRecord(src olog.Record, dst plog.LogRecord) {
+	dst.SetTimestamp(pcommon.NewTimestampFromTime(src.Timestamp()))
+	dst.SetObservedTimestamp(pcommon.NewTimestampFromTime(src.ObservedTimestamp()))
+	dst.SetSeverityNumber(plog.SeverityNumber(src.Severity()))
+	dst.SetSeverityText(src.SeverityText())
+	copyValue(src.Body(), dst.Body())
+	src.WalkAttributes(func(kv olog.KeyValue) bool {
+		copyValue(kv.Value, dst.Attributes().PutEmpty(kv.Key))
+		return true
+	})
+}
+
+func copyValue(src olog.Value, dst pcommon.Value) {
+	switch src.Kind() {
+	case olog.KindBool:
+		dst.SetBool(src.AsBool())
+	case olog.KindFloat64:
+		dst.SetDouble(src.AsFloat64())
+	case olog.KindInt64:
+		dst.SetInt(src.AsInt64())
+	case olog.KindString:
+		dst.SetStr(src.AsString())
+	case olog.KindBytes:
+		dst.SetEmptyBytes().FromRaw(src.AsBytes())
+	case olog.KindSlice:
+		slice := dst.SetEmptySlice()
+		for _, v := range src.AsSlice() {
+			copyValue(v, slice.AppendEmpty())
+		}
+	case olog.KindMap:
+		m := dst.SetEmptyMap()
+		for _, kv := range src.AsMap() {
+			copyValue(kv.Value, m.PutEmpty(kv.Key))
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package testutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ossf/gemara/layer2"
+	"github.com/ossf/gemara/layer4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/complytime/complybeacon/compass/mapper"
+	"github.com/complytime/complybeacon/compass/mapper/plugins/basic"
+	"github.com/complytime/complybeacon/proofwatch"
+)
+
+func TestPipelineEnrich(t *testing.T) {
+	basicMapper := basic.NewBasicMapper()
+	basicMapper.AddEvaluationPlan("test-catalog", layer4.AssessmentPlan{
+		Control: layer4.Mapping{EntryId: "AC-1", ReferenceId: "test-catalog"},
+		Assessments: []layer4.Assessment{
+			{
+				Requirement: layer4.Mapping{EntryId: "AC-1.1", ReferenceId: "test-catalog"},
+				Procedures:  []layer4.AssessmentProcedure{{Id: "branch-protection"}},
+			},
+		},
+	})
+	scope := mapper.Scope{
+		"test-catalog": layer2.Catalog{
+			Metadata: layer2.Metadata{Id: "test-catalog"},
+			ControlFamilies: []layer2.ControlFamily{
+				{
+					Title: "Access Control",
+					Controls: []layer2.Control{
+						{
+							Id: "AC-1",
+							GuidelineMappings: []layer2.Mapping{
+								{
+									ReferenceId: "NIST-800-53",
+									Entries:     []layer2.MappingEntry{{ReferenceId: "AC-1"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	pipeline := NewPipeline(t, mapper.Set{"test-engine": basicMapper}, scope)
+
+	tests := []struct {
+		name           string
+		procedure      string
+		result         layer4.Result
+		expectedStatus string
+		expectMapped   bool
+	}{
+		{
+			name:           "passed evidence is compliant",
+			procedure:      "branch-protection",
+			result:         layer4.Passed,
+			expectedStatus: "Compliant",
+			expectMapped:   true,
+		},
+		{
+			name:           "failed evidence is non-compliant",
+			procedure:      "branch-protection",
+			result:         layer4.Failed,
+			expectedStatus: "Non-Compliant",
+			expectMapped:   true,
+		},
+		{
+			name:      "unknown procedure is unmapped",
+			procedure: "unknown-procedure",
+			result:    layer4.Passed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evidence := proofwatch.GemaraEvidence{
+				Metadata: layer4.Metadata{
+					Id:     "audit-1",
+					Author: layer4.Author{Name: "test-engine"},
+				},
+				AssessmentLog: layer4.AssessmentLog{
+					Requirement: layer4.Mapping{EntryId: "AC-1.1", ReferenceId: "test-catalog"},
+					Procedure:   layer4.Mapping{EntryId: tt.procedure},
+					Result:      tt.result,
+					End:         "2025-01-01T00:00:00Z",
+				},
+			}
+
+			attrs, err := pipeline.Enrich(context.Background(), evidence)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.procedure, attrs[proofwatch.POLICY_RULE_ID])
+			if !tt.expectMapped {
+				assert.Equal(t, "Unmapped", attrs[proofwatch.COMPLIANCE_ENRICHMENT_STATUS])
+				assert.NotContains(t, attrs, proofwatch.COMPLIANCE_STATUS)
+				return
+			}
+			assert.Equal(t, "Success", attrs[proofwatch.COMPLIANCE_ENRICHMENT_STATUS])
+			assert.Equal(t, tt.expectedStatus, attrs[proofwatch.COMPLIANCE_STATUS])
+			assert.Equal(t, "AC-1.1", attrs[proofwatch.COMPLIANCE_CONTROL_ID])
+			assert.Equal(t, "test-catalog", attrs[proofwatch.COMPLIANCE_CONTROL_CATALOG_ID])
+			assert.Equal(t, []any{"NIST-800-53"}, attrs[proofwatch.COMPLIANCE_FRAMEWORKS])
+		})
+	}
+}
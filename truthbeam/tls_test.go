@@ -0,0 +1,185 @@
+package truthbeam
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/processor/processortest"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/complytime/complybeacon/truthbeam/internal/client"
+)
+
+// mtlsServer is a compass stub that requires a client certificate and
+// records the subject of the certificate presented for each path.
+type mtlsServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	subjects map[string]string
+}
+
+func newMTLSServer(t *testing.T, clientCA *x509.Certificate) *mtlsServer {
+	s := &mtlsServer{subjects: make(map[string]string)}
+	s.Server = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.subjects[r.URL.Path] = r.TLS.PeerCertificates[0].Subject.CommonName
+		s.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/v1/enrich" {
+			_, _ = fmt.Fprint(w, `{"compliance": {"enrichmentStatus": "Unmapped"}}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{}`)
+	}))
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCA)
+	s.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+		MinVersion: tls.VersionTLS13,
+	}
+	s.StartTLS()
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *mtlsServer) subject(path string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.subjects[path]
+}
+
+// writeClientCertificate writes a self-signed client certificate and its key
+// to dir and returns the certificate with the paths of both files.
+func writeClientCertificate(t *testing.T, dir, commonName string) (*x509.Certificate, string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, commonName+".crt")
+	keyFile := filepath.Join(dir, commonName+".key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	return cert, certFile, keyFile
+}
+
+// writeServerCA writes the certificate of an httptest TLS server to dir so
+// clients can trust it.
+func writeServerCA(t *testing.T, dir string, server *httptest.Server) string {
+	caFile := filepath.Join(dir, "compass-ca.crt")
+	block := &pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(block), 0o600))
+	return caFile
+}
+
+func TestProcessorMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	clientCert, certFile, keyFile := writeClientCertificate(t, dir, "truthbeam")
+	server := newMTLSServer(t, clientCert)
+	caFile := writeServerCA(t, dir, server.Server)
+
+	startProcessor := func(t *testing.T, withClientCert bool) *truthBeamProcessor {
+		cfg := &Config{
+			ClientConfig: confighttp.NewDefaultClientConfig(),
+			HealthCheck:  true,
+		}
+		cfg.ClientConfig.Endpoint = server.URL
+		cfg.ClientConfig.TLS.CAFile = caFile
+		if withClientCert {
+			cfg.ClientConfig.TLS.CertFile = certFile
+			cfg.ClientConfig.TLS.KeyFile = keyFile
+		}
+
+		settings := processortest.NewNopSettings(component.MustNewType("test"))
+		settings.Logger = zaptest.NewLogger(t)
+		processor, err := newTruthBeamProcessor(cfg, settings)
+		require.NoError(t, err)
+		require.NoError(t, processor.start(context.Background(), componenttest.NewNopHost()))
+		t.Cleanup(func() { assert.NoError(t, processor.shutdown(context.Background())) })
+		return processor
+	}
+
+	t.Run("client certificate is presented on every call", func(t *testing.T) {
+		processor := startProcessor(t, true)
+		applier, ok := processor.client.(*client.Applier)
+		require.True(t, ok)
+		compassClient := applier.Client()
+		ctx := context.Background()
+
+		logs := createTestLogs()
+		setRequiredAttributes(logs)
+		_, err := processor.processLogs(ctx, logs)
+		require.NoError(t, err)
+
+		resp, err := compassClient.GetV1Engines(ctx)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+
+		resp, err = compassClient.PostV1Summary(ctx, client.SummaryRequest{
+			Evidence: []client.Evidence{{
+				Timestamp:              time.Now(),
+				PolicyEngineName:       "test-source",
+				PolicyRuleId:           "test-policy-123",
+				PolicyEvaluationStatus: client.EvidencePolicyEvaluationStatusFailed,
+			}},
+		})
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+
+		// The health check on start pings the root path.
+		for _, path := range []string{"/", "/v1/enrich", "/v1/engines", "/v1/summary"} {
+			assert.Equal(t, "truthbeam", server.subject(path), "client certificate for %s", path)
+		}
+	})
+
+	t.Run("requests without a client certificate are rejected", func(t *testing.T) {
+		processor := startProcessor(t, false)
+		applier, ok := processor.client.(*client.Applier)
+		require.True(t, ok)
+		compassClient := applier.Client()
+
+		resp, err := compassClient.GetV1Engines(context.Background())
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+		assert.Error(t, err)
+	})
+}